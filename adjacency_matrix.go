@@ -0,0 +1,36 @@
+package graph
+
+// ToAdjacencyMatrix exports the graph as a dense cost matrix, e.g. for numeric and
+// linear-algebra tooling. The element [i][j] is the cost of the edge going from the
+// i-th to the j-th vertex of the returned ID slice, which lists the vertices in the
+// order they were added to the graph. Absent edges are marked with the maximum value
+// of the cost type (math.MaxInt, math.MaxFloat64 etc.), so an edge of that very cost
+// can't be told apart from a missing one.
+// If there are parallel edges between two vertices, the minimum of their costs is kept.
+// Time complexity: O(V^2 + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V^2) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) ToAdjacencyMatrix() ([][]C, []I) {
+	vertexCount := len(g.vertices)
+	absent := maxCost[C]()
+	ids := make([]I, vertexCount)
+	matrix := make([][]C, vertexCount)
+	// A single backing array keeps the rows contiguous in memory
+	cells := make([]C, vertexCount*vertexCount)
+	for i := range cells {
+		cells[i] = absent
+	}
+
+	for i := range g.vertices {
+		ids[i] = g.vertices[i].id
+		row := cells[i*vertexCount : (i+1)*vertexCount : (i+1)*vertexCount]
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if edge.cost < row[targetIdx] {
+				row[targetIdx] = edge.cost
+			}
+		}
+		matrix[i] = row
+	}
+
+	return matrix, ids
+}
@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGraphToAdjacencyMatrix(t *testing.T) {
+	t.Run("Edge costs and absent edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 4, "edge1-2")
+		builder.AddEdge(2, 3, 2.5, "edge2-3")
+		builder.AddEdge(3, 1, 7, "edge3-1")
+		builder.AddEdge(3, 3, 1, "edge3-3")
+
+		graph := builder.BuildDirected()
+		matrix, ids := graph.ToAdjacencyMatrix()
+
+		if !slicesEqual(ids, []int{1, 2, 3}) {
+			t.Fatalf("Expected ids [1 2 3], got %v", ids)
+		}
+		absent := math.MaxFloat64
+		expected := [][]float64{
+			{absent, 4, absent},
+			{absent, absent, 2.5},
+			{7, absent, 1},
+		}
+		if len(matrix) != len(expected) {
+			t.Fatalf("Expected %d rows, got %d", len(expected), len(matrix))
+		}
+		for i := range expected {
+			for j := range expected[i] {
+				if matrix[i][j] != expected[i][j] {
+					t.Errorf("Expected [%d][%d] to be %v, got %v", i, j, expected[i][j], matrix[i][j])
+				}
+			}
+		}
+	})
+
+	t.Run("Parallel edges keep the minimum cost", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 5, "edge1-2a")
+		builder.AddEdge(1, 2, 3, "edge1-2b")
+		builder.AddEdge(1, 2, 8, "edge1-2c")
+		builder.AddEdge(2, 3, 1, "edge2-3")
+
+		graph := builder.BuildDirected()
+		matrix, _ := graph.ToAdjacencyMatrix()
+
+		if matrix[0][1] != 3 {
+			t.Errorf("Expected cost 3, got %d", matrix[0][1])
+		}
+		if matrix[1][0] != math.MaxInt {
+			t.Errorf("Expected the sentinel %d, got %d", math.MaxInt, matrix[1][0])
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+
+		graph := builder.BuildDirected()
+		matrix, ids := graph.ToAdjacencyMatrix()
+
+		if len(matrix) != 0 || len(ids) != 0 {
+			t.Errorf("Expected an empty matrix, got %v and %v", matrix, ids)
+		}
+	})
+}
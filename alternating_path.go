@@ -0,0 +1,114 @@
+package graph
+
+import "container/heap"
+
+// alternatingState is a vertex reached by an edge of the given type.
+type alternatingState struct {
+	vertexIdx int
+	edgeType  int
+}
+
+// Finds the shortest path between two vertices where no two consecutive edges have
+// the same type, e.g. when a transit route has to alternate between walking and riding
+// a bus. The edgeType callback assigns a type to every edge. The search runs Dijkstra
+// over the pairs of a vertex and the type of the edge it was entered by, so a vertex
+// may be passed several times if it's entered by edges of different types.
+// Edge costs must be non-negative.
+// The Amplifier and the snapshot cost overrides are respected as by FindShortestPath.
+// Returns the path and its total cost, or nil and zero if no alternating path is found
+// or if StrictNonNegative is set and a negative edge cost is encountered.
+// Time complexity: O(T * E log(T * V)) where T is the number of edge types, E is the number
+// of edges and V is the number of vertices.
+// Space complexity: O(T * V) where T is the number of edge types and V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) FindShortestPathAlternating(start I, end I, edgeType func(*Edge[I, C]) int) ([]I, C) {
+	var zero C
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil, zero // Start vertex not found
+	}
+	endVertex, err := d.graph.GetVertexById(end)
+	if err != nil {
+		return nil, zero // End vertex not found
+	}
+	if start == end {
+		return []I{start}, zero
+	}
+
+	// The start state has no incoming edge, so it's kept out of the state index and
+	// gets the reserved index zero
+	stateIndex := make(map[alternatingState]int)
+	stateVertex := []int{startVertex.GetCustomDataIndex()}
+	stateType := []int{0}
+	stateCost := []C{0}
+	statePrevious := []int{-1}
+	stateVisited := []bool{false}
+
+	pq := &costHeap[C]{}
+	heap.Push(pq, costHeapItem[C]{cost: 0, vertexIdx: 0})
+	endIdx := endVertex.GetCustomDataIndex()
+	endState := -1
+
+	for pq.Len() > 0 {
+		current := heap.Pop(pq).(costHeapItem[C]).vertexIdx
+		if stateVisited[current] {
+			continue
+		}
+		stateVisited[current] = true
+		if stateVertex[current] == endIdx {
+			endState = current
+			break
+		}
+
+		vertex := &d.graph.vertices[stateVertex[current]]
+		for i := range vertex.edges {
+			edge := &vertex.edges[i]
+			nextType := edgeType(edge)
+			if current != 0 && nextType == stateType[current] {
+				continue // The same type as the previous edge
+			}
+
+			edgeCost, enabled, err := d.edgeCost(vertex, edge)
+			if err != nil {
+				return nil, zero // Negative edge cost
+			}
+			if !enabled {
+				continue
+			}
+
+			key := alternatingState{vertexIdx: edge.targetVertex.GetCustomDataIndex(), edgeType: nextType}
+			next, exists := stateIndex[key]
+			if !exists {
+				next = len(stateVertex)
+				stateIndex[key] = next
+				stateVertex = append(stateVertex, key.vertexIdx)
+				stateType = append(stateType, nextType)
+				stateCost = append(stateCost, 0)
+				statePrevious = append(statePrevious, -1)
+				stateVisited = append(stateVisited, false)
+			}
+			if stateVisited[next] {
+				continue
+			}
+
+			tentativeDistance := saturatingAdd(stateCost[current], edgeCost)
+			if !exists || tentativeDistance < stateCost[next] {
+				stateCost[next] = tentativeDistance
+				statePrevious[next] = current
+				heap.Push(pq, costHeapItem[C]{cost: tentativeDistance, vertexIdx: next})
+			}
+		}
+	}
+
+	if endState < 0 {
+		return nil, zero // No path found
+	}
+
+	path := []I{}
+	for state := endState; state >= 0; state = statePrevious[state] {
+		path = append(path, d.graph.vertices[stateVertex[state]].id)
+	}
+	reversePath(path)
+
+	return path, stateCost[endState]
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestFindShortestPathAlternating(t *testing.T) {
+	const (
+		walk = iota
+		bus
+	)
+	buildTransitGraph := func() *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		// The cheapest route 1->2->3->4 walks twice in a row
+		builder.AddEdge(1, 2, 1.0, "walk")
+		builder.AddEdge(2, 3, 1.0, "walk")
+		builder.AddEdge(3, 4, 1.0, "bus")
+		// The alternating route 1->2->5->4 is more expensive
+		builder.AddEdge(2, 5, 2.0, "bus")
+		builder.AddEdge(5, 4, 2.0, "walk")
+		return builder.BuildDirected()
+	}
+	edgeTypeOf := func(graph *Graph[int, float64, string, string]) func(*Edge[int, float64]) int {
+		return func(edge *Edge[int, float64]) int {
+			if data, _ := graph.GetEdgeData(edge); *data == "bus" {
+				return bus
+			}
+			return walk
+		}
+	}
+
+	t.Run("Avoids consecutive edges of the same type", func(t *testing.T) {
+		graph := buildTransitGraph()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.FindShortestPathAlternating(1, 4, edgeTypeOf(graph))
+		expected := []int{1, 2, 5, 4}
+		if !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+		if cost != 5.0 {
+			t.Errorf("Expected cost 5, got %v", cost)
+		}
+
+		// The unconstrained search takes the cheaper route
+		unconstrained := dijkstra.FindShortestPath(1, 4)
+		if !slicesEqual(unconstrained, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected unconstrained path [1 2 3 4], got %v", unconstrained)
+		}
+	})
+
+	t.Run("Revisits a vertex entered by a different type", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "walk")
+		builder.AddEdge(2, 3, 1.0, "walk")
+		builder.AddEdge(2, 4, 1.0, "bus")
+		builder.AddEdge(4, 5, 1.0, "walk")
+		builder.AddEdge(5, 2, 1.0, "bus")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		// 2 is entered by walking, so the walk to 3 requires a loop back to 2 by bus
+		path, cost := dijkstra.FindShortestPathAlternating(1, 3, edgeTypeOf(graph))
+		expected := []int{1, 2, 4, 5, 2, 3}
+		if !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+		if cost != 5.0 {
+			t.Errorf("Expected cost 5, got %v", cost)
+		}
+	})
+
+	t.Run("Impossible without alternation", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "walk")
+		builder.AddEdge(2, 3, 1.0, "walk")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.FindShortestPathAlternating(1, 3, edgeTypeOf(graph))
+		if path != nil || cost != 0 {
+			t.Errorf("Expected no path, got %v with cost %v", path, cost)
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		graph := buildTransitGraph()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.FindShortestPathAlternating(1, 1, edgeTypeOf(graph))
+		if !slicesEqual(path, []int{1}) || cost != 0 {
+			t.Errorf("Expected [1] with cost 0, got %v with cost %v", path, cost)
+		}
+	})
+
+	t.Run("Non-existent vertices", func(t *testing.T) {
+		graph := buildTransitGraph()
+		dijkstra := NewDijkstra(graph)
+
+		if path, _ := dijkstra.FindShortestPathAlternating(1, 99, edgeTypeOf(graph)); path != nil {
+			t.Errorf("Expected nil, got %v", path)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package graph
+
+// undirectedLowLink runs an iterative DFS over the given undirected adjacency lists
+// (see undirectedAdjacency) starting from every unvisited vertex, so the whole DFS
+// forest is covered. For every vertex index it returns the discovery time, the
+// low-link value (the earliest discovery time reachable through the DFS subtree and
+// at most one back edge) and the parent in the DFS forest (-1 for roots).
+// Self-loops are ignored.
+func undirectedLowLink(adjacency [][]int) (discovery []int, lowLink []int, parent []int) {
+	vertexCount := len(adjacency)
+	discovery = make([]int, vertexCount)
+	lowLink = make([]int, vertexCount)
+	parent = make([]int, vertexCount)
+	for i := range discovery {
+		discovery[i] = -1
+		parent[i] = -1
+	}
+
+	type stackItem struct {
+		vertexIdx   int
+		neighborIdx int // Index of the next neighbor to process
+	}
+	var stack []stackItem
+	time := 0
+
+	for root := range adjacency {
+		if discovery[root] >= 0 {
+			continue
+		}
+
+		discovery[root] = time
+		lowLink[root] = time
+		time++
+		stack = append(stack[:0], stackItem{vertexIdx: root})
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			currentIdx := top.vertexIdx
+
+			if top.neighborIdx < len(adjacency[currentIdx]) {
+				neighborIdx := adjacency[currentIdx][top.neighborIdx]
+				top.neighborIdx++
+				if neighborIdx == currentIdx || neighborIdx == parent[currentIdx] {
+					continue
+				}
+				if discovery[neighborIdx] < 0 {
+					// Tree edge
+					discovery[neighborIdx] = time
+					lowLink[neighborIdx] = time
+					time++
+					parent[neighborIdx] = currentIdx
+					stack = append(stack, stackItem{vertexIdx: neighborIdx})
+				} else if discovery[neighborIdx] < lowLink[currentIdx] {
+					// Back edge
+					lowLink[currentIdx] = discovery[neighborIdx]
+				}
+				continue
+			}
+
+			// All neighbors are processed, propagate the low-link value to the parent
+			stack = stack[:len(stack)-1]
+			if parentIdx := parent[currentIdx]; parentIdx >= 0 && lowLink[currentIdx] < lowLink[parentIdx] {
+				lowLink[parentIdx] = lowLink[currentIdx]
+			}
+		}
+	}
+
+	return discovery, lowLink, parent
+}
+
+// ArticulationPoints finds the cut vertices of the graph, i.e. the vertices whose
+// removal increases the number of connected components.
+// The graph is treated as undirected and disconnected graphs are handled by running
+// the search from every unvisited vertex.
+// Returns a slice of vertex IDs in vertex index order.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) ArticulationPoints() []I {
+	discovery, lowLink, parent := undirectedLowLink(g.undirectedAdjacency())
+
+	// A DFS root is a cut vertex if it has more than one child, any other vertex
+	// is a cut vertex if some child's subtree can't reach above it
+	rootChildren := make([]int, len(g.vertices))
+	isCut := make([]bool, len(g.vertices))
+	for i := range g.vertices {
+		parentIdx := parent[i]
+		if parentIdx < 0 {
+			continue
+		}
+		if parent[parentIdx] < 0 {
+			rootChildren[parentIdx]++
+		} else if lowLink[i] >= discovery[parentIdx] {
+			isCut[parentIdx] = true
+		}
+	}
+
+	var points []I
+	for i := range g.vertices {
+		if isCut[i] || rootChildren[i] > 1 {
+			points = append(points, g.vertices[i].id)
+		}
+	}
+	return points
+}
@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestArticulationPoints(t *testing.T) {
+	t.Run("Path graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		points := graph.ArticulationPoints()
+
+		expected := []int{2, 3}
+		if !slicesEqual(points, expected) {
+			t.Errorf("Expected articulation points %v, got %v", expected, points)
+		}
+	})
+
+	t.Run("Path graph starting in the middle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(2, 1, 1.0, "edge2-1")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		points := graph.ArticulationPoints()
+
+		expected := []int{2}
+		if !slicesEqual(points, expected) {
+			t.Errorf("Expected articulation points %v, got %v", expected, points)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+		points := graph.ArticulationPoints()
+
+		if len(points) != 0 {
+			t.Errorf("Expected no articulation points, got %v", points)
+		}
+	})
+
+	t.Run("Barbell graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Left bell: triangle 1-2-3
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(3, 1, 1.0, "edge3-1")
+		// Handle: 3-4
+		builder.AddBiEdge(3, 4, 1.0, "edge3-4")
+		// Right bell: triangle 4-5-6
+		builder.AddBiEdge(4, 5, 1.0, "edge4-5")
+		builder.AddBiEdge(5, 6, 1.0, "edge5-6")
+		builder.AddBiEdge(6, 4, 1.0, "edge6-4")
+
+		graph := builder.BuildDirected()
+		points := graph.ArticulationPoints()
+
+		expected := []int{3, 4}
+		if !slicesEqual(points, expected) {
+			t.Errorf("Expected articulation points %v, got %v", expected, points)
+		}
+	})
+
+	t.Run("Disconnected graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(5, 6, 1.0, "edge5-6")
+		builder.AddVertex(7, "isolated")
+
+		graph := builder.BuildDirected()
+		points := graph.ArticulationPoints()
+
+		expected := []int{2, 5}
+		if !slicesEqual(points, expected) {
+			t.Errorf("Expected articulation points %v, got %v", expected, points)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package graph
+
+import "math"
+
+// AttributeAssortativity computes the assortativity of the graph by a numeric vertex
+// attribute, i.e. the Pearson correlation of the attribute values at the two ends
+// of every edge. Positive values mean that vertices tend to connect to vertices with
+// similar values, negative values mean the opposite.
+// The value function extracts the attribute from the vertex custom data.
+// Edges are taken as directed, so add both directions (e.g. with AddBiEdge) to get
+// the undirected assortativity.
+// Returns 0 if the graph has no edges or the attribute has zero variance.
+// Time complexity: O(E) where E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func AttributeAssortativity[I Id, C Cost, V any, E any](g *Graph[I, C, V, E], value func(V) float64) float64 {
+	values := make([]float64, len(g.vertices))
+	for i := range g.vertices {
+		values[i] = value(g.customVertexData[g.vertices[i].customDataIndex])
+	}
+
+	var count, sumX, sumY, sumXY, sumXX, sumYY float64
+	for i := range g.vertices {
+		x := values[i]
+		for _, edge := range g.vertices[i].edges {
+			y := values[edge.targetVertex.GetCustomDataIndex()]
+			count++
+			sumX += x
+			sumY += y
+			sumXY += x * y
+			sumXX += x * x
+			sumYY += y * y
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+
+	covariance := sumXY/count - (sumX/count)*(sumY/count)
+	varianceX := sumXX/count - (sumX/count)*(sumX/count)
+	varianceY := sumYY/count - (sumY/count)*(sumY/count)
+	if varianceX <= 0 || varianceY <= 0 {
+		return 0
+	}
+	return covariance / math.Sqrt(varianceX*varianceY)
+}
+
+// AverageNeighborDegree computes the mean degree of the neighbors of every vertex,
+// which shows whether low-degree vertices tend to attach to high-degree hubs.
+// The graph is treated as undirected, the degree of a vertex is the number of its
+// distinct neighbors and self-loops are ignored. Isolated vertices get 0.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func AverageNeighborDegree[I Id, C Cost, V any, E any](g *Graph[I, C, V, E]) map[I]float64 {
+	adjacency := g.undirectedAdjacency()
+	degree := make([]int, len(g.vertices))
+	for i := range adjacency {
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				degree[i]++
+			}
+		}
+	}
+
+	result := make(map[I]float64, len(g.vertices))
+	for i := range g.vertices {
+		sum := 0
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				sum += degree[neighborIdx]
+			}
+		}
+		average := 0.0
+		if degree[i] > 0 {
+			average = float64(sum) / float64(degree[i])
+		}
+		result[g.vertices[i].id] = average
+	}
+	return result
+}
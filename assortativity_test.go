@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestAttributeAssortativity(t *testing.T) {
+	age := func(user User) float64 { return float64(user.Age) }
+
+	t.Run("Similar-age friendships", func(t *testing.T) {
+		builder := &Builder[int, int, User, Friendship]{}
+		builder.AddVertex(1, User{Name: "Alice", Age: 20})
+		builder.AddVertex(2, User{Name: "Bob", Age: 21})
+		builder.AddVertex(3, User{Name: "Charlie", Age: 22})
+		builder.AddVertex(4, User{Name: "Diana", Age: 60})
+		builder.AddVertex(5, User{Name: "Eve", Age: 61})
+		builder.AddVertex(6, User{Name: "Frank", Age: 62})
+		builder.AddBiEdge(1, 2, 1, Friendship{})
+		builder.AddBiEdge(2, 3, 1, Friendship{})
+		builder.AddBiEdge(4, 5, 1, Friendship{})
+		builder.AddBiEdge(5, 6, 1, Friendship{})
+
+		graph := builder.BuildDirected()
+		assortativity := AttributeAssortativity(graph, age)
+
+		if assortativity <= 0.9 {
+			t.Errorf("Expected strongly positive assortativity, got %f", assortativity)
+		}
+	})
+
+	t.Run("Dissimilar-age friendships", func(t *testing.T) {
+		builder := &Builder[int, int, User, Friendship]{}
+		builder.AddVertex(1, User{Name: "Alice", Age: 20})
+		builder.AddVertex(2, User{Name: "Bob", Age: 60})
+		builder.AddVertex(3, User{Name: "Charlie", Age: 22})
+		builder.AddVertex(4, User{Name: "Diana", Age: 62})
+		builder.AddBiEdge(1, 2, 1, Friendship{})
+		builder.AddBiEdge(3, 4, 1, Friendship{})
+		builder.AddBiEdge(1, 4, 1, Friendship{})
+
+		graph := builder.BuildDirected()
+		assortativity := AttributeAssortativity(graph, age)
+
+		if assortativity >= 0 {
+			t.Errorf("Expected negative assortativity, got %f", assortativity)
+		}
+	})
+
+	t.Run("Zero variance", func(t *testing.T) {
+		builder := &Builder[int, int, User, Friendship]{}
+		builder.AddVertex(1, User{Name: "Alice", Age: 30})
+		builder.AddVertex(2, User{Name: "Bob", Age: 30})
+		builder.AddBiEdge(1, 2, 1, Friendship{})
+
+		graph := builder.BuildDirected()
+
+		if assortativity := AttributeAssortativity(graph, age); assortativity != 0 {
+			t.Errorf("Expected 0 for zero variance, got %f", assortativity)
+		}
+	})
+
+	t.Run("No edges", func(t *testing.T) {
+		builder := &Builder[int, int, User, Friendship]{}
+		builder.AddVertex(1, User{Name: "Alice", Age: 30})
+
+		graph := builder.BuildDirected()
+
+		if assortativity := AttributeAssortativity(graph, age); assortativity != 0 {
+			t.Errorf("Expected 0 for graph without edges, got %f", assortativity)
+		}
+	})
+}
+
+func TestAverageNeighborDegree(t *testing.T) {
+	t.Run("Star graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for leaf := 1; leaf <= 4; leaf++ {
+			builder.AddBiEdge(0, leaf, 1.0, "spoke")
+		}
+
+		graph := builder.BuildDirected()
+		averages := AverageNeighborDegree(graph)
+
+		// The only neighbor of every leaf is the center of degree 4
+		for leaf := 1; leaf <= 4; leaf++ {
+			if averages[leaf] != 4 {
+				t.Errorf("Expected average neighbor degree 4 for leaf %d, got %f", leaf, averages[leaf])
+			}
+		}
+		if averages[0] != 1 {
+			t.Errorf("Expected average neighbor degree 1 for the center, got %f", averages[0])
+		}
+	})
+
+	t.Run("Directed edges are treated as undirected", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 3, 1.0, "edge3-3")
+
+		graph := builder.BuildDirected()
+		averages := AverageNeighborDegree(graph)
+
+		if averages[1] != 2 || averages[2] != 1 || averages[3] != 2 {
+			t.Errorf("Expected averages 2, 1, 2, got %v", averages)
+		}
+	})
+
+	t.Run("Isolated vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		averages := AverageNeighborDegree(graph)
+
+		if averages[1] != 0 {
+			t.Errorf("Expected 0 for isolated vertex, got %f", averages[1])
+		}
+	})
+}
@@ -9,6 +9,18 @@ import (
 // The function takes the current vertex ID and the goal vertex ID and returns the estimated cost.
 type HeuristicFunc[I Id, C Cost, V any, E any] func(origin *Vertex[I, C], goal *Vertex[I, C]) C
 
+// AStarStats describes how much work an A* search did, which helps to tune heuristics:
+// the better the heuristic, the fewer vertices are expanded.
+type AStarStats struct {
+	// The number of vertices whose outgoing edges were processed
+	ExpandedVertices int
+	// The number of edges whose relaxation was attempted, i.e. the enabled edges
+	// leading to the vertices that weren't expanded yet
+	RelaxedEdges int
+	// The maximum number of entries in the priority queue at once
+	MaxHeapSize int
+}
+
 // The A* algorithm Use-Case (aka Command) object.
 // It reuses the shared heap to limit the number of allocations during runtime,
 // but the consequence is that the algorithm is not thread-safe. You need a
@@ -28,6 +40,8 @@ type AStar[I Id, C Cost, V any, E any] struct {
 	vertexData []astarVertexData[I, C]
 	maxCost    C
 	Amplifier  CostFunc[I, C, V, E]
+	// Stats of the last FindShortestPath call.
+	Stats AStarStats
 }
 
 // Creates a new A* instance for the given graph with a heuristic function.
@@ -40,19 +54,37 @@ func NewAStar[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E], heuristic He
 		heap:       &astarHeap[I, C, V, E]{},
 		heuristic:  heuristic,
 		vertexData: vertexData,
+		maxCost:    maxCost[C](),
 	}
-	assignMaxNumber(&algorithm.maxCost)
 	algorithm.heap.algorithm = algorithm
 	return algorithm
 }
 
+// Reset rebinds the A* instance to another graph, so that one instance can be
+// reused across many graphs in batch workloads. The vertex data slice is reallocated
+// only when the new graph has more vertices than the current capacity.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (a *AStar[I, C, V, E]) Reset(graph *Graph[I, C, V, E]) {
+	a.graph = graph
+	if cap(a.vertexData) >= len(graph.vertices) {
+		a.vertexData = a.vertexData[:len(graph.vertices)]
+	} else {
+		a.vertexData = make([]astarVertexData[I, C], len(graph.vertices))
+	}
+	// Drop the vertices of the previous graph possibly left in the heap
+	a.heap.pq = a.heap.pq[:0]
+}
+
 // Finds the shortest path between two vertices in the graph using A* algorithm.
 // Returns a slice of vertex IDs representing the shortest path.
 // Returns nil if no path is found.
+// The work done by the search is recorded in Stats.
 // Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
 // Space complexity: O(V) where V is the number of vertices.
 // WARNING: This function is not thread-safe and should not be called concurrently.
 func (a *AStar[I, C, V, E]) FindShortestPath(start I, end I) []I {
+	a.Stats = AStarStats{}
+
 	// Check if start and end vertices exist
 	startVertex, err := a.graph.GetVertexById(start)
 	if err != nil {
@@ -72,19 +104,23 @@ func (a *AStar[I, C, V, E]) FindShortestPath(start I, end I) []I {
 	// Initialize vertex data for all vertices
 	for i := range a.vertexData {
 		a.vertexData[i].visited = false
+		a.vertexData[i].reached = false
 		a.vertexData[i].previous = nil
+		a.vertexData[i].previousEdge = nil
 		a.vertexData[i].gScore = a.maxCost
 		a.vertexData[i].fScore = a.maxCost
 	}
 
-	// Initialize priority queue
-	heap.Init(a.heap)
+	// Initialize priority queue, dropping the vertices left by a previous search
+	a.heap.pq = a.heap.pq[:0]
 
 	// Set start vertex g-score to 0 and calculate f-score
 	startIdx := startVertex.GetCustomDataIndex()
 	a.vertexData[startIdx].gScore = 0
+	a.vertexData[startIdx].reached = true
 	a.vertexData[startIdx].fScore = a.heuristic(startVertex, endVertex)
 	heap.Push(a.heap, startVertex)
+	a.Stats.MaxHeapSize = 1
 
 	// Main A* loop
 	for a.heap.Len() > 0 {
@@ -105,9 +141,10 @@ func (a *AStar[I, C, V, E]) FindShortestPath(start I, end I) []I {
 		if current.id == end {
 			break
 		}
+		a.Stats.ExpandedVertices++
 
 		// Process all neighbors
-		for _, edge := range current.edges {
+		for i, edge := range current.edges {
 			neighbor := edge.targetVertex
 			neighborIdx := neighbor.GetCustomDataIndex()
 			neighborData := &a.vertexData[neighborIdx]
@@ -126,16 +163,22 @@ func (a *AStar[I, C, V, E]) FindShortestPath(start I, end I) []I {
 				}
 				edgeCost = cost
 			}
+			a.Stats.RelaxedEdges++
 
 			// Calculate tentative g-score (cost from start to neighbor)
-			tentativeGScore := currentData.gScore + edgeCost
+			tentativeGScore := saturatingAdd(currentData.gScore, edgeCost)
 
 			// If this is a better path to the neighbor
-			if tentativeGScore < neighborData.gScore {
+			if !neighborData.reached || tentativeGScore < neighborData.gScore {
+				neighborData.reached = true
 				neighborData.gScore = tentativeGScore
-				neighborData.fScore = tentativeGScore + a.heuristic(neighbor, endVertex)
+				neighborData.fScore = saturatingAdd(tentativeGScore, a.heuristic(neighbor, endVertex))
 				neighborData.previous = current
+				neighborData.previousEdge = &current.edges[i]
 				heap.Push(a.heap, neighbor)
+				if a.heap.Len() > a.Stats.MaxHeapSize {
+					a.Stats.MaxHeapSize = a.heap.Len()
+				}
 			}
 		}
 	}
@@ -155,9 +198,106 @@ func (a *AStar[I, C, V, E]) FindShortestPath(start I, end I) []I {
 	}
 
 	// Reverse the path to get start-to-end order
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
-	}
+	reversePath(path)
 
 	return path
 }
+
+// FindShortestPathEdges finds the shortest path like FindShortestPath, but returns the
+// edges the path consists of rather than the vertex IDs, so that their custom data can
+// be accessed without looking them up again. If the vertices are connected by parallel
+// edges, the exact edge chosen during the search is returned.
+// Returns an empty slice if start and end are the same vertex, or nil if no path is found.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (a *AStar[I, C, V, E]) FindShortestPathEdges(start I, end I) []*Edge[I, C] {
+	path := a.FindShortestPath(start, end)
+	if path == nil {
+		return nil
+	}
+
+	edges := make([]*Edge[I, C], len(path)-1)
+	data := &a.vertexData[a.graph.idToIndex[end]]
+	for i := len(edges) - 1; i >= 0; i-- {
+		edges[i] = data.previousEdge
+		data = &a.vertexData[data.previous.GetCustomDataIndex()]
+	}
+	return edges
+}
+
+// ValidateHeuristic checks the heuristic against the goal vertex, which helps to debug
+// a heuristic that makes A* find suboptimal paths. It's a development-time aid that
+// computes the true distances from all the vertices to the goal with Dijkstra's
+// algorithm over the reversed edges, so it's much more expensive than a search.
+// The heuristic is admissible if it never overestimates the true distance to the goal
+// (the vertices that can't reach the goal aren't constrained), and consistent if for
+// every edge u->v it doesn't exceed the edge cost plus the heuristic of v, in which case
+// A* never has to expand a vertex twice. The Amplifier is respected as by FindShortestPath.
+// Edge costs must be non-negative. Returns false for both if the goal vertex doesn't exist.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (a *AStar[I, C, V, E]) ValidateHeuristic(goal I) (admissible bool, consistent bool) {
+	goalVertex, err := a.graph.GetVertexById(goal)
+	if err != nil {
+		return false, false
+	}
+
+	// Collect the reversed edges, checking the consistency along the way
+	consistent = true
+	reversed := make([][]costArc[C], len(a.graph.vertices))
+	for i := range a.graph.vertices {
+		origin := &a.graph.vertices[i]
+		originHeuristic := a.heuristic(origin, goalVertex)
+		for j := range origin.edges {
+			edge := &origin.edges[j]
+			edgeCost := edge.cost
+			if a.Amplifier != nil {
+				cost, enabled := a.Amplifier(origin, edge)
+				if !enabled {
+					continue
+				}
+				edgeCost = cost
+			}
+			if originHeuristic > saturatingAdd(edgeCost, a.heuristic(edge.targetVertex, goalVertex)) {
+				consistent = false
+			}
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			reversed[targetIdx] = append(reversed[targetIdx], costArc[C]{targetIdx: i, cost: edgeCost})
+		}
+	}
+
+	// Find the true distances to the goal
+	distance := make([]C, len(a.graph.vertices))
+	reached := make([]bool, len(a.graph.vertices))
+	settled := make([]bool, len(a.graph.vertices))
+	goalIdx := goalVertex.GetCustomDataIndex()
+	reached[goalIdx] = true
+	pq := &costHeap[C]{}
+	heap.Push(pq, costHeapItem[C]{cost: 0, vertexIdx: goalIdx})
+	for pq.Len() > 0 {
+		currentIdx := heap.Pop(pq).(costHeapItem[C]).vertexIdx
+		if settled[currentIdx] {
+			continue
+		}
+		settled[currentIdx] = true
+		for _, arc := range reversed[currentIdx] {
+			tentativeDistance := saturatingAdd(distance[currentIdx], arc.cost)
+			if !settled[arc.targetIdx] && (!reached[arc.targetIdx] || tentativeDistance < distance[arc.targetIdx]) {
+				reached[arc.targetIdx] = true
+				distance[arc.targetIdx] = tentativeDistance
+				heap.Push(pq, costHeapItem[C]{cost: tentativeDistance, vertexIdx: arc.targetIdx})
+			}
+		}
+	}
+
+	admissible = true
+	for i := range a.graph.vertices {
+		if reached[i] && a.heuristic(&a.graph.vertices[i], goalVertex) > distance[i] {
+			admissible = false
+			break
+		}
+	}
+
+	return admissible, consistent
+}
@@ -3,9 +3,13 @@ package graph
 // The data that is attached to the vertices by the A* algorithms.
 type astarVertexData[I Id, C Cost] struct {
 	previous *Vertex[I, C]
-	visited  bool
-	gScore   C // Cost from start to this vertex
-	fScore   C // gScore + heuristic estimate to goal
+	// The exact edge leading from the previous vertex, which matters for parallel edges
+	previousEdge *Edge[I, C]
+	visited      bool
+	// Whether the scores have been set, so any value of the cost type is a valid distance
+	reached bool
+	gScore  C // Cost from start to this vertex
+	fScore  C // gScore + heuristic estimate to goal
 }
 
 // astarHeap implements heap.Interface for the priority queue
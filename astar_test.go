@@ -371,6 +371,40 @@ func TestAStarFindShortestPath(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Saturated uint8 costs", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		builder.AddEdge(1, 2, 200, "edge1-2")
+		builder.AddEdge(2, 3, 55, "edge2-3")
+		builder.AddEdge(3, 4, 0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		astar := NewAStar(graph, zeroHeuristic[int, uint8, string, string])
+
+		// The distance of 255 equals the max value of uint8, yet vertex 4 is reachable
+		path := astar.FindShortestPath(1, 4)
+		expectedPath := []int{1, 2, 3, 4}
+		if !slicesEqualAStar(path, expectedPath) {
+			t.Errorf("Expected path %v, got %v", expectedPath, path)
+		}
+	})
+
+	t.Run("Near-max uint16 costs don't overflow", func(t *testing.T) {
+		builder := &Builder[int, uint16, string, string]{}
+		builder.AddEdge(1, 2, 40000, "edge1-2")
+		builder.AddEdge(2, 3, 40000, "edge2-3")
+		builder.AddEdge(1, 3, 60000, "edge1-3")
+
+		graph := builder.BuildDirected()
+		astar := NewAStar(graph, zeroHeuristic[int, uint16, string, string])
+
+		// 40000 + 40000 would wrap around to 14464 and win over the direct edge
+		path := astar.FindShortestPath(1, 3)
+		expectedPath := []int{1, 3}
+		if !slicesEqualAStar(path, expectedPath) {
+			t.Errorf("Expected path %v, got %v", expectedPath, path)
+		}
+	})
 }
 
 func TestAStarWithAmplifier(t *testing.T) {
@@ -761,3 +795,168 @@ func slicesEqualString(a, b []string) bool {
 	}
 	return true
 }
+
+func TestAStarFindShortestPathEdges(t *testing.T) {
+	t.Run("Parallel edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "slow")
+		builder.AddEdge(1, 2, 2.0, "fast")
+		builder.AddEdge(2, 3, 1.0, "fast")
+		builder.AddEdge(2, 3, 4.0, "slow")
+
+		graph := builder.BuildDirected()
+		astar := NewAStar(graph, zeroHeuristic[int, float64, string, string])
+
+		edges := astar.FindShortestPathEdges(1, 3)
+		if len(edges) != 2 {
+			t.Fatalf("Expected 2 edges, got %d", len(edges))
+		}
+		expectedCosts := []float64{2.0, 1.0}
+		expectedTargets := []int{2, 3}
+		for i, edge := range edges {
+			if edge.GetCost() != expectedCosts[i] || edge.GetTargetVertex().GetId() != expectedTargets[i] {
+				t.Errorf("Expected edge to %d with cost %v, got edge to %d with cost %v",
+					expectedTargets[i], expectedCosts[i], edge.GetTargetVertex().GetId(), edge.GetCost())
+			}
+			if data, _ := graph.GetEdgeData(edge); *data != "fast" {
+				t.Errorf("Expected edge data fast, got %s", *data)
+			}
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		astar := NewAStar(graph, zeroHeuristic[int, float64, string, string])
+
+		edges := astar.FindShortestPathEdges(1, 1)
+		if edges == nil || len(edges) != 0 {
+			t.Errorf("Expected an empty slice, got %v", edges)
+		}
+	})
+
+	t.Run("No path", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		astar := NewAStar(graph, zeroHeuristic[int, float64, string, string])
+
+		if edges := astar.FindShortestPathEdges(2, 1); edges != nil {
+			t.Errorf("Expected nil, got %v", edges)
+		}
+	})
+}
+
+func TestAStarValidateHeuristic(t *testing.T) {
+	t.Run("Manhattan distance on a grid", func(t *testing.T) {
+		graph := GenerateGrid[string, string](5, 5, false)
+		astar := NewAStar(graph, GridManhattanHeuristic[string, string](5))
+
+		admissible, consistent := astar.ValidateHeuristic(24)
+		if !admissible || !consistent {
+			t.Errorf("Expected admissible and consistent, got %v and %v", admissible, consistent)
+		}
+	})
+
+	t.Run("Inflated heuristic is inadmissible", func(t *testing.T) {
+		graph := GenerateGrid[string, string](5, 5, false)
+		manhattan := GridManhattanHeuristic[string, string](5)
+		inflated := func(origin *Vertex[int, float64], goal *Vertex[int, float64]) float64 {
+			return 3 * manhattan(origin, goal)
+		}
+		astar := NewAStar(graph, inflated)
+
+		if admissible, _ := astar.ValidateHeuristic(24); admissible {
+			t.Errorf("Expected the inflated heuristic to be inadmissible")
+		}
+	})
+
+	t.Run("Manhattan distance with diagonals is inadmissible", func(t *testing.T) {
+		graph := GenerateGrid[string, string](5, 5, true)
+		astar := NewAStar(graph, GridManhattanHeuristic[string, string](5))
+
+		if admissible, _ := astar.ValidateHeuristic(24); admissible {
+			t.Errorf("Expected the Manhattan distance to be inadmissible with diagonals")
+		}
+	})
+
+	t.Run("Admissible but inconsistent heuristic", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		estimates := map[int]float64{1: 2.0, 2: 0.0, 3: 0.0}
+		astar := NewAStar(graph, func(origin *Vertex[int, float64], goal *Vertex[int, float64]) float64 {
+			return estimates[origin.id]
+		})
+
+		admissible, consistent := astar.ValidateHeuristic(3)
+		if !admissible || consistent {
+			t.Errorf("Expected admissible and inconsistent, got %v and %v", admissible, consistent)
+		}
+	})
+
+	t.Run("Non-existent goal", func(t *testing.T) {
+		graph := GenerateGrid[string, string](2, 2, false)
+		astar := NewAStar(graph, GridManhattanHeuristic[string, string](2))
+
+		admissible, consistent := astar.ValidateHeuristic(99)
+		if admissible || consistent {
+			t.Errorf("Expected false for both, got %v and %v", admissible, consistent)
+		}
+	})
+}
+
+func TestAStarStats(t *testing.T) {
+	// The graph of the A* vs Dijkstra comparison, extended with a cheap dead end (vertex 0)
+	// which only the search without a heuristic bothers to expand
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 4, 100.0, "direct")
+	builder.AddEdge(1, 2, 1.0, "start-middle1")
+	builder.AddEdge(2, 3, 1.0, "middle1-middle2")
+	builder.AddEdge(3, 4, 1.0, "middle2-goal")
+	builder.AddEdge(1, 0, 0.5, "dead-end")
+
+	graph := builder.BuildDirected()
+	goodHeur := func(current *Vertex[int, float64], goal *Vertex[int, float64]) float64 {
+		return float64(goal.id - current.id) // Simple linear heuristic
+	}
+	astarGood := NewAStar(graph, goodHeur)
+	// The zero heuristic makes A* expand the same vertices as Dijkstra
+	astarZero := NewAStar(graph, zeroHeuristic[int, float64, string, string])
+
+	pathGood := astarGood.FindShortestPath(1, 4)
+	pathZero := astarZero.FindShortestPath(1, 4)
+	expected := []int{1, 2, 3, 4}
+	if !slicesEqual(pathGood, expected) || !slicesEqual(pathZero, expected) {
+		t.Fatalf("Expected path %v, got %v and %v", expected, pathGood, pathZero)
+	}
+
+	t.Run("Good heuristic expands fewer vertices", func(t *testing.T) {
+		if astarGood.Stats.ExpandedVertices >= astarZero.Stats.ExpandedVertices {
+			t.Errorf("Expected fewer expanded vertices than %d, got %d",
+				astarZero.Stats.ExpandedVertices, astarGood.Stats.ExpandedVertices)
+		}
+	})
+
+	t.Run("Counts the work of the zero heuristic", func(t *testing.T) {
+		// Vertices 1, 0, 2 and 3 are expanded, while the goal isn't
+		expectedStats := AStarStats{ExpandedVertices: 4, RelaxedEdges: 5, MaxHeapSize: 3}
+		if astarZero.Stats != expectedStats {
+			t.Errorf("Expected %+v, got %+v", expectedStats, astarZero.Stats)
+		}
+	})
+
+	t.Run("Stats are reset by every search", func(t *testing.T) {
+		// Vertex 4 has no outgoing edges, so the search ends after expanding it
+		astarZero.FindShortestPath(4, 1)
+		expectedStats := AStarStats{ExpandedVertices: 1, MaxHeapSize: 1}
+		if astarZero.Stats != expectedStats {
+			t.Errorf("Expected %+v, got %+v", expectedStats, astarZero.Stats)
+		}
+	})
+}
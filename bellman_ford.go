@@ -1,5 +1,14 @@
 package graph
 
+import (
+	"context"
+	"errors"
+)
+
+// ErrNegativeCycle is returned by shortest path algorithms when a negative cycle
+// reachable from the start vertex makes the shortest path undefined.
+var ErrNegativeCycle = errors.New("graph contains a negative cycle")
+
 // The Bellman-Ford algorithm Use-Case (aka Command) object.
 // It reuses the shared vertex data to limit the number of allocations during runtime,
 // but the consequence is that the algorithm is not thread-safe. You need a
@@ -27,11 +36,24 @@ func NewBellmanFord[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *Bellm
 	algorithm := &BellmanFord[I, C, V, E]{
 		graph:      graph,
 		vertexData: vertexData,
+		maxCost:    maxCost[C](),
 	}
-	assignMaxNumber(&algorithm.maxCost)
 	return algorithm
 }
 
+// Reset rebinds the Bellman-Ford instance to another graph, so that one instance can be
+// reused across many graphs in batch workloads. The vertex data slice is reallocated
+// only when the new graph has more vertices than the current capacity.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (bf *BellmanFord[I, C, V, E]) Reset(graph *Graph[I, C, V, E]) {
+	bf.graph = graph
+	if cap(bf.vertexData) >= len(graph.vertices) {
+		bf.vertexData = bf.vertexData[:len(graph.vertices)]
+	} else {
+		bf.vertexData = make([]bellmanFordVertexData[I, C], len(graph.vertices))
+	}
+}
+
 // Finds the shortest path between two vertices in the graph.
 // Returns a slice of vertex IDs representing the shortest path.
 // Returns nil if no path is found or if a negative cycle is detected.
@@ -39,49 +61,191 @@ func NewBellmanFord[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *Bellm
 // Space complexity: O(V) where V is the number of vertices.
 // WARNING: This function is not thread-safe and should not be called concurrently.
 func (bf *BellmanFord[I, C, V, E]) FindShortestPath(start I, end I) []I {
+	path, _ := bf.findShortestPath(context.Background(), start, end)
+	return path
+}
+
+// FindShortestPathCtx finds the shortest path like FindShortestPath, but periodically
+// checks the context and aborts the search as soon as it's cancelled, which prevents
+// wasting resources when e.g. the client of a server has disconnected.
+// Returns nil and the context error if the context has been cancelled.
+// Otherwise returns the same path as FindShortestPath and a nil error.
+// The context is checked before every round of the edge relaxation.
+// Time complexity: O(VE) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (bf *BellmanFord[I, C, V, E]) FindShortestPathCtx(ctx context.Context, start I, end I) ([]I, error) {
+	path, err := bf.findShortestPath(ctx, start, end)
+	if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		return nil, err
+	}
+	return path, nil
+}
+
+// FindShortestPathE finds the shortest path like FindShortestPath, but reports why
+// there is no path, so a negative cycle can be told apart from an unreachable end.
+// Returns ErrNegativeCycle if a negative cycle is reachable from the start vertex,
+// ErrNoPath if the end vertex isn't reachable, or an error if either vertex doesn't exist.
+// The path is nil in all of these cases.
+// Time complexity: O(VE) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (bf *BellmanFord[I, C, V, E]) FindShortestPathE(start I, end I) ([]I, error) {
+	return bf.findShortestPath(context.Background(), start, end)
+}
+
+// findShortestPath implements FindShortestPath, FindShortestPathCtx and FindShortestPathE.
+func (bf *BellmanFord[I, C, V, E]) findShortestPath(ctx context.Context, start I, end I) ([]I, error) {
 	// Check if start and end vertices exist
 	startVertex, err := bf.graph.GetVertexById(start)
 	if err != nil {
-		return nil // Start vertex not found
+		return nil, err
 	}
 
 	endVertex, err := bf.graph.GetVertexById(end)
 	if err != nil {
-		return nil // End vertex not found
+		return nil, err
 	}
 
 	// If start and end are the same, return the start vertex
 	if start == end {
-		return []I{start}
+		return []I{start}, nil
 	}
 
 	// Initialize vertex data for all vertices
 	for i := range bf.vertexData {
 		bf.vertexData[i].previous = nil
+		bf.vertexData[i].reached = false
 		bf.vertexData[i].cost = bf.maxCost
 	}
 
 	// Set start vertex distance to 0
 	startIdx := startVertex.GetCustomDataIndex()
 	bf.vertexData[startIdx].cost = 0
+	bf.vertexData[startIdx].reached = true
 
 	// Relax all edges V-1 times
 	for i := 0; i < len(bf.graph.vertices)-1; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		bf.relaxAllEdges()
 	}
 
 	// Check for negative cycles by trying to relax edges one more time
 	if bf.hasNegativeCycle() {
-		return nil // Negative cycle detected
+		return nil, ErrNegativeCycle
 	}
 
 	// Check if end vertex is reachable
 	endIdx := endVertex.GetCustomDataIndex()
-	if bf.vertexData[endIdx].cost == bf.maxCost {
+	if !bf.vertexData[endIdx].reached {
+		return nil, ErrNoPath
+	}
+
+	return bf.reconstructPath(endVertex), nil
+}
+
+// Finds the shortest path between two vertices like FindShortestPath, but with the
+// Shortest Path Faster Algorithm (SPFA), a queue-based Bellman-Ford variant. Instead of
+// relaxing all the edges V-1 times, it only relaxes the edges of the vertices whose
+// distance has changed, which is much faster on sparse graphs in practice.
+// A negative cycle reachable from the start vertex is detected when a path would
+// consist of V or more edges.
+// Returns nil if no path is found or if a negative cycle is detected.
+// Time complexity: O(VE) in the worst case, but typically O(E) on sparse graphs, where
+// E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (bf *BellmanFord[I, C, V, E]) FindShortestPathSPFA(start I, end I) []I {
+	// Check if start and end vertices exist
+	startVertex, err := bf.graph.GetVertexById(start)
+	if err != nil {
+		return nil // Start vertex not found
+	}
+
+	endVertex, err := bf.graph.GetVertexById(end)
+	if err != nil {
+		return nil // End vertex not found
+	}
+
+	// If start and end are the same, return the start vertex
+	if start == end {
+		return []I{start}
+	}
+
+	// Initialize vertex data for all vertices
+	for i := range bf.vertexData {
+		bf.vertexData[i].previous = nil
+		bf.vertexData[i].reached = false
+		bf.vertexData[i].cost = bf.maxCost
+		bf.vertexData[i].inQueue = false
+		bf.vertexData[i].pathLength = 0
+	}
+
+	// Set start vertex distance to 0 and add to queue
+	startIdx := startVertex.GetCustomDataIndex()
+	bf.vertexData[startIdx].cost = 0
+	bf.vertexData[startIdx].reached = true
+	bf.vertexData[startIdx].inQueue = true
+	queue := &indexDeque{}
+	queue.pushBack(startIdx)
+
+	for queue.size > 0 {
+		currentIdx := queue.popFront()
+		current := &bf.graph.vertices[currentIdx]
+		currentData := &bf.vertexData[currentIdx]
+		currentData.inQueue = false
+
+		// Process all outgoing edges
+		for _, edge := range current.edges {
+			neighbor := edge.targetVertex
+			neighborIdx := neighbor.GetCustomDataIndex()
+			neighborData := &bf.vertexData[neighborIdx]
+
+			edgeCost := edge.cost
+
+			if bf.Amplifier != nil {
+				cost, enabled := bf.Amplifier(current, &edge)
+				if !enabled {
+					continue
+				}
+				edgeCost = cost
+			}
+
+			// Calculate tentative distance
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
+
+			// If this is a better path to the neighbor
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
+				neighborData.cost = tentativeDistance
+				neighborData.previous = current
+				neighborData.pathLength = currentData.pathLength + 1
+
+				// A simple path has at most V-1 edges, so a longer one goes around
+				// a negative cycle
+				if neighborData.pathLength >= len(bf.graph.vertices) {
+					return nil // Negative cycle detected
+				}
+				if !neighborData.inQueue {
+					neighborData.inQueue = true
+					queue.pushBack(neighborIdx)
+				}
+			}
+		}
+	}
+
+	// Check if end vertex is reachable
+	if !bf.vertexData[endVertex.GetCustomDataIndex()].reached {
 		return nil // No path found
 	}
 
-	// Reconstruct path by following previous pointers
+	return bf.reconstructPath(endVertex)
+}
+
+// reconstructPath follows the previous pointers from the end vertex back to the start one.
+func (bf *BellmanFord[I, C, V, E]) reconstructPath(endVertex *Vertex[I, C]) []I {
 	path := []I{}
 	current := endVertex
 	for current != nil {
@@ -91,9 +255,7 @@ func (bf *BellmanFord[I, C, V, E]) FindShortestPath(start I, end I) []I {
 	}
 
 	// Reverse the path to get start-to-end order
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
-	}
+	reversePath(path)
 
 	return path
 }
@@ -107,7 +269,7 @@ func (bf *BellmanFord[I, C, V, E]) relaxAllEdges() {
 		currentData := &bf.vertexData[currentIdx]
 
 		// Skip if current vertex is not reachable
-		if currentData.cost == bf.maxCost {
+		if !currentData.reached {
 			continue
 		}
 
@@ -128,10 +290,11 @@ func (bf *BellmanFord[I, C, V, E]) relaxAllEdges() {
 			}
 
 			// Calculate tentative distance
-			tentativeDistance := currentData.cost + edgeCost
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
 
 			// If this is a better path to the neighbor
-			if tentativeDistance < neighborData.cost {
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
 				neighborData.cost = tentativeDistance
 				neighborData.previous = current
 			}
@@ -148,7 +311,7 @@ func (bf *BellmanFord[I, C, V, E]) hasNegativeCycle() bool {
 		currentData := &bf.vertexData[currentIdx]
 
 		// Skip if current vertex is not reachable
-		if currentData.cost == bf.maxCost {
+		if !currentData.reached {
 			continue
 		}
 
@@ -169,7 +332,7 @@ func (bf *BellmanFord[I, C, V, E]) hasNegativeCycle() bool {
 			}
 
 			// Calculate tentative distance
-			tentativeDistance := currentData.cost + edgeCost
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
 
 			// If we can still improve the distance, there's a negative cycle
 			if tentativeDistance < neighborData.cost {
@@ -194,12 +357,14 @@ func (bf *BellmanFord[I, C, V, E]) HasNegativeCycle(start I) bool {
 	// Initialize vertex data for all vertices
 	for i := range bf.vertexData {
 		bf.vertexData[i].previous = nil
+		bf.vertexData[i].reached = false
 		bf.vertexData[i].cost = bf.maxCost
 	}
 
 	// Set start vertex distance to 0
 	startIdx := startVertex.GetCustomDataIndex()
 	bf.vertexData[startIdx].cost = 0
+	bf.vertexData[startIdx].reached = true
 
 	// Relax all edges V-1 times
 	for i := 0; i < len(bf.graph.vertices)-1; i++ {
@@ -3,5 +3,11 @@ package graph
 // The data that is attached to the vertices by the Bellman-Ford algorithm.
 type bellmanFordVertexData[I Id, C Cost] struct {
 	previous *Vertex[I, C]
-	cost     C
+	// Whether the cost has been set, so any value of the cost type is a valid distance
+	reached bool
+	cost    C
+	// Whether the vertex is in the SPFA work queue
+	inQueue bool
+	// The number of edges of the path found so far, used by SPFA to detect negative cycles
+	pathLength int
 }
@@ -1,7 +1,10 @@
 package graph
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestNewBellmanFord(t *testing.T) {
@@ -346,6 +349,40 @@ func TestBellmanFordWithDifferentTypes(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Saturated uint8 costs", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		builder.AddEdge(1, 2, 200, "edge1-2")
+		builder.AddEdge(2, 3, 55, "edge2-3")
+		builder.AddEdge(3, 4, 0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		bellmanFord := NewBellmanFord(graph)
+
+		// The distance of 255 equals the max value of uint8, yet vertex 4 is reachable
+		path := bellmanFord.FindShortestPath(1, 4)
+		expectedPath := []int{1, 2, 3, 4}
+		if !slicesEqual(path, expectedPath) {
+			t.Errorf("Expected path %v, got %v", expectedPath, path)
+		}
+	})
+
+	t.Run("Near-max uint16 costs don't overflow", func(t *testing.T) {
+		builder := &Builder[int, uint16, string, string]{}
+		builder.AddEdge(1, 2, 40000, "edge1-2")
+		builder.AddEdge(2, 3, 40000, "edge2-3")
+		builder.AddEdge(1, 3, 60000, "edge1-3")
+
+		graph := builder.BuildDirected()
+		bellmanFord := NewBellmanFord(graph)
+
+		// 40000 + 40000 would wrap around to 14464 and win over the direct edge
+		path := bellmanFord.FindShortestPath(1, 3)
+		expectedPath := []int{1, 3}
+		if !slicesEqual(path, expectedPath) {
+			t.Errorf("Expected path %v, got %v", expectedPath, path)
+		}
+	})
 }
 
 func TestBellmanFordWithAmplifier(t *testing.T) {
@@ -590,3 +627,192 @@ func TestBellmanFordHasNegativeCycle(t *testing.T) {
 		}
 	})
 }
+
+func TestBellmanFordFindShortestPathCtx(t *testing.T) {
+	graph := GenerateGrid[string, string](100, 100, false)
+
+	t.Run("Completes with a live context", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, -0.5, "edge2-3")
+
+		bellmanFord := NewBellmanFord(builder.BuildDirected())
+		path, err := bellmanFord.FindShortestPathCtx(context.Background(), 1, 3)
+
+		if err != nil || !slicesEqual(path, []int{1, 2, 3}) {
+			t.Errorf("Expected path [1 2 3] and no error, got %v, %v", path, err)
+		}
+	})
+
+	t.Run("Returns promptly when cancelled", func(t *testing.T) {
+		bellmanFord := NewBellmanFord(graph)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		started := time.Now()
+		path, err := bellmanFord.FindShortestPathCtx(ctx, 0, 100*100-1)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if path != nil {
+			t.Errorf("Expected nil path, got %d vertices", len(path))
+		}
+		if elapsed := time.Since(started); elapsed > 100*time.Millisecond {
+			t.Errorf("Expected prompt return, took %v", elapsed)
+		}
+	})
+}
+
+func TestBellmanFordFindShortestPathE(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 1.0, "1-2")
+	builder.AddEdge(2, 3, 1.0, "2-3")
+	builder.AddEdge(3, 1, -3.0, "3-1") // Negative cycle
+	builder.AddEdge(4, 5, 2.0, "4-5")
+	builder.AddVertex(6, "F")
+
+	graph := builder.BuildDirected()
+	bf := NewBellmanFord(graph)
+
+	t.Run("Negative cycle", func(t *testing.T) {
+		path, err := bf.FindShortestPathE(1, 3)
+
+		if !errors.Is(err, ErrNegativeCycle) || path != nil {
+			t.Errorf("Expected ErrNegativeCycle, got %v, %v", path, err)
+		}
+	})
+
+	t.Run("Disconnected target", func(t *testing.T) {
+		path, err := bf.FindShortestPathE(4, 6)
+
+		if !errors.Is(err, ErrNoPath) || path != nil {
+			t.Errorf("Expected ErrNoPath, got %v, %v", path, err)
+		}
+	})
+
+	t.Run("Path found", func(t *testing.T) {
+		path, err := bf.FindShortestPathE(4, 5)
+
+		if err != nil || !slicesEqual(path, []int{4, 5}) {
+			t.Errorf("Expected path [4 5] and no error, got %v, %v", path, err)
+		}
+	})
+
+	t.Run("Non-existent vertex", func(t *testing.T) {
+		if path, err := bf.FindShortestPathE(1, 99); err == nil || path != nil {
+			t.Errorf("Expected an error, got %v, %v", path, err)
+		}
+	})
+
+	t.Run("Old methods keep returning nil", func(t *testing.T) {
+		if path := bf.FindShortestPath(1, 3); path != nil {
+			t.Errorf("Expected nil path, got %v", path)
+		}
+		if path, err := bf.FindShortestPathCtx(context.Background(), 4, 6); path != nil || err != nil {
+			t.Errorf("Expected nil path and no error, got %v, %v", path, err)
+		}
+	})
+}
+
+func TestBellmanFordFindShortestPathSPFA(t *testing.T) {
+	type testCase struct {
+		name      string
+		edges     [][3]int // Origin, target and cost
+		amplifier CostFunc[int, int, string, string]
+		start     int
+		end       int
+		expected  []int
+	}
+	testCases := []testCase{
+		{name: "Simple path between two vertices", edges: [][3]int{{1, 2, 10}}, start: 1, end: 2, expected: []int{1, 2}},
+		{name: "Same start and end vertex", edges: [][3]int{{1, 2, 10}}, start: 1, end: 1, expected: []int{1}},
+		{name: "Non-existent start vertex", edges: [][3]int{{1, 2, 10}}, start: 99, end: 2},
+		{name: "Non-existent end vertex", edges: [][3]int{{1, 2, 10}}, start: 1, end: 99},
+		{name: "No path between vertices", edges: [][3]int{{1, 2, 10}, {3, 4, 10}}, start: 1, end: 4},
+		{
+			name:     "Complex graph with multiple paths",
+			edges:    [][3]int{{1, 2, 10}, {1, 3, 5}, {2, 4, 20}, {3, 4, 15}},
+			start:    1,
+			end:      4,
+			expected: []int{1, 3, 4},
+		},
+		{
+			name:     "Graph with negative edge weights",
+			edges:    [][3]int{{1, 2, 2}, {1, 3, 5}, {2, 4, 3}, {3, 4, 3}, {2, 3, -1}},
+			start:    1,
+			end:      4,
+			expected: []int{1, 2, 3, 4},
+		},
+		{name: "Graph with negative cycle", edges: [][3]int{{1, 2, 1}, {2, 3, 1}, {3, 1, -3}}, start: 1, end: 3},
+		{
+			name:  "Negative cycle away from the end vertex",
+			edges: [][3]int{{1, 2, 1}, {1, 3, 1}, {3, 4, 1}, {4, 3, -3}},
+			start: 1,
+			end:   2,
+		},
+		{name: "Zero cost edges", edges: [][3]int{{1, 2, 0}, {2, 3, 0}}, start: 1, end: 3, expected: []int{1, 2, 3}},
+		{
+			name:  "Amplifier disables specific edges",
+			edges: [][3]int{{1, 2, 1}, {1, 3, 2}, {2, 4, 1}, {3, 4, 2}},
+			amplifier: func(origin *Vertex[int, int], edge *Edge[int, int]) (int, bool) {
+				return edge.cost, !(origin.id == 1 && edge.targetVertex.id == 2)
+			},
+			start:    1,
+			end:      4,
+			expected: []int{1, 3, 4},
+		},
+		{
+			name:  "Amplifier disables all edges",
+			edges: [][3]int{{1, 2, 1}, {2, 3, 1}},
+			amplifier: func(origin *Vertex[int, int], edge *Edge[int, int]) (int, bool) {
+				return 0, false
+			},
+			start: 1,
+			end:   3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := &Builder[int, int, string, string]{}
+			for _, edge := range tc.edges {
+				builder.AddEdge(edge[0], edge[1], edge[2], "edge")
+			}
+			graph := builder.BuildDirected()
+			bf := NewBellmanFord(graph)
+			bf.Amplifier = tc.amplifier
+
+			dense := bf.FindShortestPath(tc.start, tc.end)
+			spfa := bf.FindShortestPathSPFA(tc.start, tc.end)
+			if !slicesEqual(dense, tc.expected) || (dense == nil) != (tc.expected == nil) {
+				t.Errorf("Expected dense path %v, got %v", tc.expected, dense)
+			}
+			if !slicesEqual(spfa, dense) || (spfa == nil) != (dense == nil) {
+				t.Errorf("Expected SPFA path %v, got %v", dense, spfa)
+			}
+		})
+	}
+
+	t.Run("Matches the dense version on random graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(30, 0.1, seed,
+				func(origin int, target int) int { return (origin*31+target)%13 - 1 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			bf := NewBellmanFord(graph)
+
+			for end := 1; end < 30; end++ {
+				dense := bf.FindShortestPath(0, end)
+				denseCost := bf.vertexData[graph.idToIndex[end]].cost
+				spfa := bf.FindShortestPathSPFA(0, end)
+				spfaCost := bf.vertexData[graph.idToIndex[end]].cost
+				if (dense == nil) != (spfa == nil) || (dense != nil && denseCost != spfaCost) {
+					t.Errorf("Seed %d, end %d: expected cost %d (path %v), got %d (path %v)",
+						seed, end, denseCost, dense, spfaCost, spfa)
+				}
+			}
+		}
+	})
+}
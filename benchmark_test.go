@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"container/heap"
 	"testing"
 )
 
@@ -41,6 +42,35 @@ func BenchmarkBuilderAddBiEdge(b *testing.B) {
 	}
 }
 
+func BenchmarkBuilderAddEdgesPerEdge(b *testing.B) {
+	dtos := make([]EdgeDto[int, float64, bool], 100000)
+	for i := range dtos {
+		dtos[i] = &BasicEdgeDto[int, float64, bool]{Origin: i % 1000, Target: (i + 1) % 1000, Cost: float64(i), Data: true}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		builder := &Builder[int, float64, string, bool]{}
+		for _, dto := range dtos {
+			builder.AddEdgeDto(dto)
+		}
+	}
+}
+
+func BenchmarkBuilderAddEdgesBatch(b *testing.B) {
+	dtos := make([]EdgeDto[int, float64, bool], 100000)
+	for i := range dtos {
+		dtos[i] = &BasicEdgeDto[int, float64, bool]{Origin: i % 1000, Target: (i + 1) % 1000, Cost: float64(i), Data: true}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		builder := &Builder[int, float64, string, bool]{}
+		builder.Reserve(1000, len(dtos))
+		builder.AddEdges(dtos)
+	}
+}
+
 func BenchmarkBuildDirected(b *testing.B) {
 	builder := &Builder[int, float64, string, bool]{}
 
@@ -670,3 +700,205 @@ func BenchmarkConnectedComponentsGetComponentForVertex(b *testing.B) {
 		_ = cc.GetComponentForVertex(500)
 	}
 }
+
+func BenchmarkDijkstraRandomGraph(b *testing.B) {
+	// Build a random graph with 1000 vertices and about 5000 edges
+	graph := GenerateRandom(1000, 0.005, 1,
+		func(origin int, target int) float64 { return float64((origin*31+target)%100 + 1) },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) bool { return true },
+	)
+	dijkstra := NewDijkstra(graph)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = dijkstra.FindShortestPath(0, 999)
+	}
+}
+
+func BenchmarkAStarGrid100x100(b *testing.B) {
+	graph := GenerateGrid[string, bool](100, 100, false)
+	astar := NewAStar(graph, GridManhattanHeuristic[string, bool](100))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = astar.FindShortestPath(0, 100*100-1)
+	}
+}
+
+func BenchmarkNewDijkstra(b *testing.B) {
+	builder := &Builder[int, float64, string, bool]{}
+	builder.AddEdge(0, 1, 1.0, true)
+	graph := builder.BuildDirected()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = NewDijkstra(graph)
+	}
+}
+
+func BenchmarkZeroOneBFSVsDijkstra(b *testing.B) {
+	// Build a random graph with 1000 vertices and about 5000 edges costing 0 or 1
+	graph := GenerateRandom(1000, 0.005, 1,
+		func(origin int, target int) float64 { return float64((origin*31 + target) % 2) },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) bool { return true },
+	)
+	dijkstra := NewDijkstra(graph)
+
+	b.Run("ZeroOneBFS", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = graph.ZeroOneBFS(0, 999)
+		}
+	})
+
+	b.Run("Dijkstra", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = dijkstra.FindShortestPath(0, 999)
+		}
+	})
+}
+
+func BenchmarkDialVsDijkstra(b *testing.B) {
+	// Build a random graph with 10000 vertices and about 50000 edges costing up to 10
+	graph := GenerateRandom(10000, 0.0005, 1,
+		func(origin int, target int) int { return (origin*31+target)%10 + 1 },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) bool { return true },
+	)
+	dijkstra := NewDijkstra(graph)
+
+	b.Run("Dial", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = DialShortestPath(graph, 0, 9999)
+		}
+	})
+
+	b.Run("Dijkstra", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = dijkstra.FindShortestPath(0, 9999)
+		}
+	})
+}
+
+func BenchmarkBellmanFordSPFAVsDense(b *testing.B) {
+	// Build a sparse random graph with 1000 vertices and about 3000 edges
+	graph := GenerateRandom(1000, 0.003, 1,
+		func(origin int, target int) float64 { return float64((origin*31+target)%100 + 1) },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) bool { return true },
+	)
+	bellmanFord := NewBellmanFord(graph)
+
+	b.Run("SPFA", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = bellmanFord.FindShortestPathSPFA(0, 999)
+		}
+	})
+
+	b.Run("Dense", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = bellmanFord.FindShortestPath(0, 999)
+		}
+	})
+}
+
+// lazyDijkstraPushes runs Dijkstra's algorithm with lazy deletion, which pushes a new
+// heap entry on every improvement and skips the outdated ones when they're popped.
+// Returns the number of pushes.
+func lazyDijkstraPushes[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E], startIdx int, endIdx int) int {
+	distance := make([]C, len(graph.vertices))
+	reached := make([]bool, len(graph.vertices))
+	visited := make([]bool, len(graph.vertices))
+	reached[startIdx] = true
+	pq := &costHeap[C]{}
+	heap.Push(pq, costHeapItem[C]{cost: 0, vertexIdx: startIdx})
+	pushes := 1
+	for pq.Len() > 0 {
+		currentIdx := heap.Pop(pq).(costHeapItem[C]).vertexIdx
+		if visited[currentIdx] {
+			continue
+		}
+		visited[currentIdx] = true
+		if currentIdx == endIdx {
+			break
+		}
+		for _, edge := range graph.vertices[currentIdx].edges {
+			neighborIdx := edge.targetVertex.GetCustomDataIndex()
+			tentativeDistance := distance[currentIdx] + edge.cost
+			if !visited[neighborIdx] && (!reached[neighborIdx] || tentativeDistance < distance[neighborIdx]) {
+				reached[neighborIdx] = true
+				distance[neighborIdx] = tentativeDistance
+				heap.Push(pq, costHeapItem[C]{cost: tentativeDistance, vertexIdx: neighborIdx})
+				pushes++
+			}
+		}
+	}
+	return pushes
+}
+
+func BenchmarkDijkstraIndexedHeapDenseGraph(b *testing.B) {
+	// Build a dense random graph with 1000 vertices and about 200000 edges
+	graph := GenerateRandom(1000, 0.2, 1,
+		func(origin int, target int) float64 { return float64((origin*31+target)%100 + 1) },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) bool { return true },
+	)
+	dijkstra := NewDijkstra(graph)
+
+	b.Run("Indexed", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = dijkstra.FindShortestPath(0, 999)
+		}
+		b.StopTimer()
+		// Every reached vertex is pushed exactly once
+		pushes := 0
+		for i := range dijkstra.vertexData {
+			if dijkstra.vertexData[i].reached {
+				pushes++
+			}
+		}
+		b.ReportMetric(float64(pushes), "pushes/op")
+	})
+
+	b.Run("LazyDeletion", func(b *testing.B) {
+		pushes := 0
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			pushes = lazyDijkstraPushes(graph, 0, 999)
+		}
+		b.ReportMetric(float64(pushes), "pushes/op")
+	})
+}
+
+func BenchmarkDinicVsEdmondsKarp(b *testing.B) {
+	// Build a dense random network with 300 vertices and about 27000 edges
+	graph := GenerateRandom(300, 0.3, 1,
+		func(origin int, target int) int { return (origin*31+target)%100 + 1 },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) bool { return true },
+	)
+	mf := NewMaxFlow(graph)
+
+	b.Run("Dinic", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = mf.DinicMaxFlow(0, 299)
+		}
+	})
+
+	b.Run("EdmondsKarp", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = mf.MaxFlow(0, 299)
+		}
+	})
+}
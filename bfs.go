@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrQueueSizeExceeded is returned by the checked BFS traversal when the queue
+// grows beyond BFS.MaxQueueSize.
+var ErrQueueSizeExceeded = errors.New("bfs queue size limit exceeded")
+
+// The data that is attached to the vertices by the BFS algorithm.
+type bfsVertexData[I Id, C Cost] struct {
+	visited bool
+	parent  *Vertex[I, C]
+}
+
+// The BFS algorithm Use-Case (aka Command) object.
+// It provides methods to perform breadth-first search operations on the graph.
+// The algorithm is not thread-safe and should not be called concurrently.
+type BFS[I Id, C Cost, V any, E any] struct {
+	graph      *Graph[I, C, V, E]
+	vertexData []bfsVertexData[I, C]
+	// The queue is reused between calls to limit the number of allocations.
+	queue []bfsQueueItem[I, C]
+	// MaxQueueSize limits the number of vertices waiting in the queue during
+	// a checked traversal (see TraverseFromChecked). This bounds the memory used
+	// on untrusted, adversarial inputs. Zero means unbounded.
+	MaxQueueSize int
+	// MaxBranch limits the number of outgoing edges expanded from each vertex,
+	// which enables approximate (beam-search style) exploration of dense graphs.
+	// Only the MaxBranch best edges according to BranchLess are followed.
+	// Zero means no limit.
+	MaxBranch int
+	// BranchLess orders the edges when MaxBranch is set. If nil, the cheapest
+	// edges are preferred.
+	BranchLess BranchLessFunc[I, C]
+	branchBuf  []int
+}
+
+// TraversalItem is a vertex visited by a streaming traversal together with the edge
+// that led to it, which is nil for the start vertex.
+type TraversalItem[I Id, C Cost] struct {
+	Vertex I
+	Edge   *Edge[I, C]
+}
+
+// bfsQueueItem is a vertex waiting in the BFS queue together with the edge that led to it.
+type bfsQueueItem[I Id, C Cost] struct {
+	vertex *Vertex[I, C]
+	edge   *Edge[I, C]
+}
+
+// Creates a new BFS instance for the given graph.
+// This function is thread-safe and can be called concurrently as long as the
+// graph doesn't change.
+func NewBFS[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *BFS[I, C, V, E] {
+	vertexData := make([]bfsVertexData[I, C], len(graph.vertices))
+	algorithm := &BFS[I, C, V, E]{
+		graph:      graph,
+		vertexData: vertexData,
+	}
+	return algorithm
+}
+
+// Reset rebinds the BFS instance to another graph, so that one instance can be
+// reused across many graphs in batch workloads. The vertex data slice is reallocated
+// only when the new graph has more vertices than the current capacity.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (b *BFS[I, C, V, E]) Reset(graph *Graph[I, C, V, E]) {
+	b.graph = graph
+	if cap(b.vertexData) >= len(graph.vertices) {
+		b.vertexData = b.vertexData[:len(graph.vertices)]
+	} else {
+		b.vertexData = make([]bfsVertexData[I, C], len(graph.vertices))
+	}
+}
+
+// TraverseFrom performs a breadth-first search starting from the given vertex,
+// calling the provided callback function for each vertex and edge visited.
+// The callback receives the current vertex and the edge that led to it (nil for the start vertex).
+// MaxQueueSize is ignored, use TraverseFromChecked to enforce it.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (b *BFS[I, C, V, E]) TraverseFrom(start I, callback func(vertex *Vertex[I, C], edge *Edge[I, C])) {
+	// Check if start vertex exists
+	startVertex, err := b.graph.GetVertexById(start)
+	if err != nil {
+		return // Start vertex not found
+	}
+
+	b.bfsTraverseWithCallback(startVertex, 0, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		callback(vertex, edge)
+		return true
+	})
+}
+
+// TraverseFromChecked performs a breadth-first search starting from the given vertex
+// like TraverseFrom, but aborts the traversal and returns ErrQueueSizeExceeded as soon
+// as the number of queued vertices exceeds MaxQueueSize (unless it is zero).
+// Returns an error if the start vertex doesn't exist.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(min(V, MaxQueueSize)) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (b *BFS[I, C, V, E]) TraverseFromChecked(start I, callback func(vertex *Vertex[I, C], edge *Edge[I, C])) error {
+	// Check if start vertex exists
+	startVertex, err := b.graph.GetVertexById(start)
+	if err != nil {
+		return err
+	}
+
+	completed := b.bfsTraverseWithCallback(startVertex, b.MaxQueueSize, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		callback(vertex, edge)
+		return true
+	})
+	if !completed {
+		return ErrQueueSizeExceeded
+	}
+	return nil
+}
+
+// EdgesInBFSOrder returns the edges of the BFS tree rooted at the start vertex in the
+// order they were first traversed, which is useful for animating the exploration.
+// Only the edges that led to newly discovered vertices are included, so there are
+// exactly as many edges as reachable vertices minus one.
+// Returns nil if the start vertex doesn't exist.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (b *BFS[I, C, V, E]) EdgesInBFSOrder(start I) []EdgeDto[I, C, E] {
+	startVertex, err := b.graph.GetVertexById(start)
+	if err != nil {
+		return nil
+	}
+
+	var edges []EdgeDto[I, C, E]
+	b.bfsTraverseWithCallback(startVertex, 0, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		if edge == nil {
+			return true // The start vertex
+		}
+		edges = append(edges, &BasicEdgeDto[I, C, E]{
+			Origin: b.vertexData[vertex.GetCustomDataIndex()].parent.id,
+			Target: vertex.id,
+			Cost:   edge.cost,
+			Data:   b.graph.customEdgeData[edge.customDataIndex],
+		})
+		return true
+	})
+	return edges
+}
+
+// Layers groups the vertices reachable from the start vertex by their BFS distance,
+// i.e. the number of edges, so that Layers[d] contains all the vertices exactly d hops
+// away, e.g. the friends of friends in a social graph at the distance 2. The first layer
+// is the start vertex itself, and the unreachable vertices are excluded.
+// MaxBranch is respected as by TraverseFrom.
+// Returns nil if the start vertex doesn't exist.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (b *BFS[I, C, V, E]) Layers(start I) [][]I {
+	startVertex, err := b.graph.GetVertexById(start)
+	if err != nil {
+		return nil
+	}
+
+	var layers [][]I
+	distance := make([]int, len(b.graph.vertices))
+	b.bfsTraverseWithCallback(startVertex, 0, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		idx := vertex.GetCustomDataIndex()
+		if edge != nil {
+			distance[idx] = distance[b.vertexData[idx].parent.GetCustomDataIndex()] + 1
+		}
+		// The vertices are visited in the order of their distance
+		if distance[idx] == len(layers) {
+			layers = append(layers, nil)
+		}
+		layers[distance[idx]] = append(layers[distance[idx]], vertex.id)
+		return true
+	})
+	return layers
+}
+
+// FindNearestSource finds which of the source vertices is the nearest to the target one
+// by the number of edges, e.g. for "nearest facility" queries. All the sources are
+// enqueued at once, so a single BFS answers the query. If several sources are equally
+// near, the one listed first wins. The non-existent sources are ignored.
+// MaxBranch is respected as by TraverseFrom.
+// Returns the nearest source and the path from it to the target, or the zero ID and nil
+// if the target doesn't exist or isn't reachable from any source.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (b *BFS[I, C, V, E]) FindNearestSource(sources []I, target I) (I, []I) {
+	var zero I
+	targetVertex, err := b.graph.GetVertexById(target)
+	if err != nil {
+		return zero, nil
+	}
+	startVertices := make([]*Vertex[I, C], 0, len(sources))
+	for _, source := range sources {
+		if vertex, err := b.graph.GetVertexById(source); err == nil {
+			startVertices = append(startVertices, vertex)
+		}
+	}
+
+	found := false
+	b.bfsTraverseManyWithCallback(startVertices, 0, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		found = vertex == targetVertex
+		return !found
+	})
+	if !found {
+		return zero, nil
+	}
+
+	path := []I{}
+	for current := targetVertex; current != nil; current = b.vertexData[current.GetCustomDataIndex()].parent {
+		path = append(path, current.id)
+	}
+	reversePath(path)
+
+	return path[0], path
+}
+
+// StreamFrom performs a breadth-first search starting from the given vertex in a
+// separate goroutine and emits each visited vertex together with the edge that led
+// to it (nil for the start vertex) on the returned channel, so that the downstream
+// stages of a pipeline can process the vertices as they're discovered.
+// The channel is closed when the traversal completes or the context is cancelled.
+// The channel is unbuffered, so the traversal advances only as fast as the items
+// are received. The channel is closed right away if the start vertex doesn't exist.
+// MaxQueueSize is ignored, but MaxBranch is respected as by TraverseFrom.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: The BFS instance must not be used until the channel is closed.
+func (b *BFS[I, C, V, E]) StreamFrom(ctx context.Context, start I) <-chan TraversalItem[I, C] {
+	items := make(chan TraversalItem[I, C])
+	startVertex, err := b.graph.GetVertexById(start)
+	if err != nil {
+		close(items)
+		return items // Start vertex not found
+	}
+
+	go func() {
+		defer close(items)
+		b.bfsTraverseWithCallback(startVertex, 0, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+			// The select below picks randomly when both cases are ready
+			if ctx.Err() != nil {
+				return false
+			}
+			select {
+			case items <- TraversalItem[I, C]{Vertex: vertex.id, Edge: edge}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return items
+}
+
+// bfsTraverseWithCallback performs BFS traversal with a callback function.
+// It marks all reachable vertices as visited and calls the callback for each vertex and edge.
+// The traversal stops as soon as the callback returns false.
+// If maxQueueSize is positive and the queue grows beyond it, the traversal is aborted
+// and false is returned.
+func (b *BFS[I, C, V, E]) bfsTraverseWithCallback(startVertex *Vertex[I, C], maxQueueSize int, callback func(vertex *Vertex[I, C], edge *Edge[I, C]) bool) bool {
+	return b.bfsTraverseManyWithCallback([]*Vertex[I, C]{startVertex}, maxQueueSize, callback)
+}
+
+// bfsTraverseManyWithCallback performs BFS traversal like bfsTraverseWithCallback, but
+// starts from all the given vertices at once, as if they were connected to a virtual
+// source. The start vertices are visited first in the given order, duplicates are skipped.
+func (b *BFS[I, C, V, E]) bfsTraverseManyWithCallback(startVertices []*Vertex[I, C], maxQueueSize int, callback func(vertex *Vertex[I, C], edge *Edge[I, C]) bool) bool {
+	// Initialize vertex data for all vertices
+	for i := range b.vertexData {
+		b.vertexData[i].visited = false
+		b.vertexData[i].parent = nil
+	}
+
+	// Vertices are marked visited when enqueued, so each one is queued only once
+	queue := b.queue[:0]
+	for _, startVertex := range startVertices {
+		startData := &b.vertexData[startVertex.GetCustomDataIndex()]
+		if !startData.visited {
+			startData.visited = true
+			queue = append(queue, bfsQueueItem[I, C]{vertex: startVertex})
+		}
+	}
+	defer func() { b.queue = queue[:0] }()
+
+	for len(queue) > 0 {
+		// Dequeue by reslicing, so that appends reallocate the queue based on the
+		// number of waiting vertices rather than the number of visited ones
+		item := queue[0]
+		queue = queue[1:]
+		current := item.vertex
+		if !callback(current, item.edge) {
+			return true
+		}
+
+		b.branchBuf = selectBranches(current.edges, b.MaxBranch, b.BranchLess, b.branchBuf)
+		for _, i := range b.branchBuf {
+			neighbor := current.edges[i].targetVertex
+			neighborData := &b.vertexData[neighbor.GetCustomDataIndex()]
+			if neighborData.visited {
+				continue
+			}
+			neighborData.visited = true
+			neighborData.parent = current
+			queue = append(queue, bfsQueueItem[I, C]{vertex: neighbor, edge: &current.edges[i]})
+			if maxQueueSize > 0 && len(queue) > maxQueueSize {
+				return false
+			}
+		}
+	}
+
+	return true
+}
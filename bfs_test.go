@@ -0,0 +1,420 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewBFS(t *testing.T) {
+	t.Run("Create BFS for simple graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "A")
+		builder.AddVertex(2, "B")
+		builder.AddEdge(1, 2, 10.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		if bfs == nil {
+			t.Error("Expected BFS instance, got nil")
+			return
+		}
+
+		if bfs.graph != graph {
+			t.Error("Expected BFS graph to match input graph")
+		}
+
+		if bfs.MaxQueueSize != 0 {
+			t.Errorf("Expected unbounded queue by default, got %d", bfs.MaxQueueSize)
+		}
+	})
+
+	t.Run("Create BFS for empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		if bfs == nil {
+			t.Error("Expected BFS instance for empty graph, got nil")
+		}
+	})
+}
+
+func TestBFSTraverseFrom(t *testing.T) {
+	t.Run("Visits vertices level by level", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 5, 1.0, "edge3-5")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		var visitedVertices []int
+		var visitedEdges []*Edge[int, float64]
+		bfs.TraverseFrom(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			visitedVertices = append(visitedVertices, vertex.GetId())
+			visitedEdges = append(visitedEdges, edge)
+		})
+
+		expected := []int{1, 2, 3, 4, 5}
+		if !slicesEqual(visitedVertices, expected) {
+			t.Errorf("Expected visit order %v, got %v", expected, visitedVertices)
+		}
+
+		if visitedEdges[0] != nil {
+			t.Error("Expected nil edge for start vertex")
+		}
+
+		for i := 1; i < len(visitedEdges); i++ {
+			if visitedEdges[i].GetTargetVertex().GetId() != visitedVertices[i] {
+				t.Errorf("Expected edge leading to vertex %d", visitedVertices[i])
+			}
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		bfs.TraverseFrom(999, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			t.Error("Expected no callbacks for non-existent start vertex")
+		})
+	})
+}
+
+func TestBFSTraverseFromChecked(t *testing.T) {
+	// Star graph: the center has 10 leaves, all of them are queued at once
+	builder := &Builder[int, float64, string, string]{}
+	for i := 1; i <= 10; i++ {
+		builder.AddEdge(0, i, 1.0, "edge")
+	}
+	graph := builder.BuildDirected()
+
+	t.Run("Small cap on a wide graph", func(t *testing.T) {
+		bfs := NewBFS(graph)
+		bfs.MaxQueueSize = 5
+
+		err := bfs.TraverseFromChecked(0, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {})
+		if !errors.Is(err, ErrQueueSizeExceeded) {
+			t.Errorf("Expected ErrQueueSizeExceeded, got %v", err)
+		}
+	})
+
+	t.Run("Cap large enough", func(t *testing.T) {
+		bfs := NewBFS(graph)
+		bfs.MaxQueueSize = 10
+
+		count := 0
+		err := bfs.TraverseFromChecked(0, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			count++
+		})
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if count != 11 {
+			t.Errorf("Expected 11 vertices visited, got %d", count)
+		}
+	})
+
+	t.Run("Unbounded by default", func(t *testing.T) {
+		bfs := NewBFS(graph)
+
+		if err := bfs.TraverseFromChecked(0, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {}); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		bfs := NewBFS(graph)
+
+		if err := bfs.TraverseFromChecked(999, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {}); err == nil {
+			t.Error("Expected error for non-existent start vertex")
+		}
+	})
+}
+
+func TestBFSMaxBranch(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 5.0, "weak")
+	builder.AddEdge(1, 3, 1.0, "strong")
+	builder.AddEdge(3, 4, 1.0, "strong")
+	builder.AddEdge(3, 5, 9.0, "weak")
+	graph := builder.BuildDirected()
+
+	t.Run("Branching limited to the single best edge", func(t *testing.T) {
+		bfs := NewBFS(graph)
+		bfs.MaxBranch = 1
+
+		var visited []int
+		bfs.TraverseFrom(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			visited = append(visited, vertex.GetId())
+		})
+
+		expected := []int{1, 3, 4}
+		if !slicesEqual(visited, expected) {
+			t.Errorf("Expected %v, got %v", expected, visited)
+		}
+	})
+
+	t.Run("Branching limited to two edges", func(t *testing.T) {
+		bfs := NewBFS(graph)
+		bfs.MaxBranch = 2
+
+		var visited []int
+		bfs.TraverseFrom(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			visited = append(visited, vertex.GetId())
+		})
+
+		// Best edges are expanded first
+		expected := []int{1, 3, 2, 4, 5}
+		if !slicesEqual(visited, expected) {
+			t.Errorf("Expected %v, got %v", expected, visited)
+		}
+	})
+}
+
+func TestBFSEdgesInBFSOrder(t *testing.T) {
+	t.Run("Tree edges in discovery order", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 2.0, "edge1-3")
+		builder.AddEdge(2, 4, 3.0, "edge2-4")
+		builder.AddEdge(3, 4, 4.0, "edge3-4")
+		builder.AddEdge(4, 1, 5.0, "edge4-1")
+		builder.AddEdge(4, 5, 6.0, "edge4-5")
+		builder.AddEdge(6, 1, 7.0, "edge6-1")
+
+		graph := builder.BuildDirected()
+		edges := NewBFS(graph).EdgesInBFSOrder(1)
+
+		// Vertex 6 isn't reachable, so 5 vertices are connected by 4 tree edges
+		if len(edges) != 4 {
+			t.Fatalf("Expected 4 edges, got %d", len(edges))
+		}
+
+		expected := []string{"edge1-2", "edge1-3", "edge2-4", "edge4-5"}
+		discovered := map[int]bool{1: true}
+		for i, edge := range edges {
+			if edge.GetData() != expected[i] {
+				t.Errorf("Expected edge %s at %d, got %s", expected[i], i, edge.GetData())
+			}
+			// Every edge must grow the tree from an already discovered vertex
+			if !discovered[edge.GetOrigin()] || discovered[edge.GetTarget()] {
+				t.Errorf("Edge %d->%d doesn't extend the tree", edge.GetOrigin(), edge.GetTarget())
+			}
+			discovered[edge.GetTarget()] = true
+		}
+	})
+
+	t.Run("Isolated start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+
+		graph := builder.BuildDirected()
+		edges := NewBFS(graph).EdgesInBFSOrder(1)
+
+		if len(edges) != 0 {
+			t.Errorf("Expected no edges, got %d", len(edges))
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+
+		if edges := NewBFS(graph).EdgesInBFSOrder(999); edges != nil {
+			t.Errorf("Expected nil, got %v", edges)
+		}
+	})
+}
+
+func TestBFSStreamFrom(t *testing.T) {
+	buildChain := func(length int) *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i < length; i++ {
+			builder.AddEdge(i, i+1, 1.0, "edge")
+		}
+		return builder.BuildDirected()
+	}
+
+	t.Run("Emits all reachable vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(5, 1, 1.0, "edge5-1") // Unreachable from 1
+
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		var visited []int
+		for item := range bfs.StreamFrom(context.Background(), 1) {
+			if (item.Edge == nil) != (item.Vertex == 1) {
+				t.Errorf("Expected nil edge only for the start vertex, got %v for %d", item.Edge, item.Vertex)
+			}
+			if item.Edge != nil && item.Edge.GetTargetVertex().GetId() != item.Vertex {
+				t.Errorf("Expected the edge to lead to %d, got %d", item.Vertex, item.Edge.GetTargetVertex().GetId())
+			}
+			visited = append(visited, item.Vertex)
+		}
+
+		expected := []int{1, 2, 3, 4}
+		if !slicesEqual(visited, expected) {
+			t.Errorf("Expected %v, got %v", expected, visited)
+		}
+	})
+
+	t.Run("Cancellation stops the emission", func(t *testing.T) {
+		graph := buildChain(1000)
+		bfs := NewBFS(graph)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		items := bfs.StreamFrom(ctx, 1)
+		for i := 0; i < 3; i++ {
+			<-items
+		}
+		cancel()
+
+		// At most the item being sent at the moment of the cancellation may arrive
+		remaining := 0
+		for range items {
+			remaining++
+		}
+		if remaining > 1 {
+			t.Errorf("Expected at most 1 item after cancellation, got %d", remaining)
+		}
+	})
+
+	t.Run("Cancelled context", func(t *testing.T) {
+		graph := buildChain(10)
+		bfs := NewBFS(graph)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		count := 0
+		for range bfs.StreamFrom(ctx, 1) {
+			count++
+		}
+		if count != 0 {
+			t.Errorf("Expected no items, got %d", count)
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		graph := buildChain(3)
+		bfs := NewBFS(graph)
+
+		count := 0
+		for range bfs.StreamFrom(context.Background(), 99) {
+			count++
+		}
+		if count != 0 {
+			t.Errorf("Expected no items, got %d", count)
+		}
+	})
+}
+
+func TestBFSLayers(t *testing.T) {
+	t.Run("Binary tree", func(t *testing.T) {
+		// A complete binary tree of depth 3, where vertex i has children 2i and 2i+1
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i < 8; i++ {
+			builder.AddEdge(i, 2*i, 1.0, "left")
+			builder.AddEdge(i, 2*i+1, 1.0, "right")
+		}
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		layers := bfs.Layers(1)
+		if len(layers) != 4 {
+			t.Fatalf("Expected 4 layers, got %d: %v", len(layers), layers)
+		}
+		for d, layer := range layers {
+			if len(layer) != 1<<d {
+				t.Errorf("Expected %d vertices at the distance %d, got %v", 1<<d, d, layer)
+			}
+			for _, vertex := range layer {
+				if vertex < 1<<d || vertex >= 2<<d {
+					t.Errorf("Expected vertex %d not to be at the distance %d", vertex, d)
+				}
+			}
+		}
+	})
+
+	t.Run("Shortcuts and unreachable vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		layers := bfs.Layers(1)
+		if len(layers) != 2 || !slicesEqual(layers[0], []int{1}) || !slicesEqual(layers[1], []int{2, 3}) {
+			t.Errorf("Expected layers [[1] [2 3]], got %v", layers)
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		graph := builder.BuildDirected()
+		bfs := NewBFS(graph)
+
+		if layers := bfs.Layers(99); layers != nil {
+			t.Errorf("Expected nil, got %v", layers)
+		}
+	})
+}
+
+func TestBFSFindNearestSource(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 5, 10.0, "edge1-5")
+	builder.AddEdge(10, 11, 2.0, "edge10-11")
+	builder.AddEdge(11, 5, 2.0, "edge11-5")
+	builder.AddVertex(20, "isolated")
+	graph := builder.BuildDirected()
+
+	t.Run("Target is attributed to the source fewer hops away", func(t *testing.T) {
+		bfs := NewBFS(graph)
+
+		// The costs are ignored, so the single edge from 1 wins
+		source, path := bfs.FindNearestSource([]int{10, 1}, 5)
+		if source != 1 || !slicesEqual(path, []int{1, 5}) {
+			t.Errorf("Expected source 1 with path [1 5], got %d with %v", source, path)
+		}
+	})
+
+	t.Run("Ties go to the source listed first", func(t *testing.T) {
+		bfs := NewBFS(graph)
+
+		source, path := bfs.FindNearestSource([]int{10, 11}, 11)
+		if source != 11 || !slicesEqual(path, []int{11}) {
+			t.Errorf("Expected source 11 with path [11], got %d with %v", source, path)
+		}
+		source, _ = bfs.FindNearestSource([]int{1, 11}, 5)
+		if source != 1 {
+			t.Errorf("Expected source 1, got %d", source)
+		}
+	})
+
+	t.Run("Unreachable target", func(t *testing.T) {
+		bfs := NewBFS(graph)
+
+		if source, path := bfs.FindNearestSource([]int{1, 10, 99}, 20); source != 0 || path != nil {
+			t.Errorf("Expected no source, got %d with %v", source, path)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package graph
+
+// Bipartition splits the vertices into two sets such that every edge connects
+// a vertex of one set with a vertex of the other one.
+// The graph is treated as undirected and every connected component is 2-colored
+// independently with a breadth-first search.
+// Returns the two sets and true if the graph is bipartite. Otherwise returns nil sets and false.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) Bipartition() (left []I, right []I, ok bool) {
+	adjacency := g.undirectedAdjacency()
+
+	// Colors: -1 - not colored yet, 0 - left, 1 - right
+	color := make([]int8, len(g.vertices))
+	for i := range color {
+		color[i] = -1
+	}
+	queue := make([]int, 0, len(g.vertices))
+
+	for start := range g.vertices {
+		if color[start] >= 0 {
+			continue
+		}
+
+		// Color the whole component of the start vertex
+		color[start] = 0
+		queue = append(queue[:0], start)
+		for head := 0; head < len(queue); head++ {
+			currentIdx := queue[head]
+			for _, neighborIdx := range adjacency[currentIdx] {
+				if color[neighborIdx] < 0 {
+					color[neighborIdx] = 1 - color[currentIdx]
+					queue = append(queue, neighborIdx)
+				} else if color[neighborIdx] == color[currentIdx] {
+					return nil, nil, false // Odd cycle found
+				}
+			}
+		}
+	}
+
+	for i := range g.vertices {
+		if color[i] == 0 {
+			left = append(left, g.vertices[i].id)
+		} else {
+			right = append(right, g.vertices[i].id)
+		}
+	}
+
+	return left, right, true
+}
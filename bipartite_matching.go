@@ -0,0 +1,137 @@
+package graph
+
+// MaximumBipartiteMatching finds a maximum matching between the two given partitions of
+// the vertices with the Hopcroft–Karp algorithm, e.g. for assigning workers to tasks:
+// the largest set of edges connecting the left vertices with the right ones such that
+// no two of them share a vertex. Each phase finds a maximal set of the shortest
+// vertex-disjoint augmenting paths, so only O(sqrt(V)) phases are needed.
+// The graph is treated as undirected, so an edge connects the partitions in either
+// direction. The partitions are assumed to be disjoint and aren't validated: the edges
+// within a partition or leading out of both of them are simply ignored, as are the IDs
+// that don't exist in the graph (see Bipartition to find the partitions).
+// Returns the matched pairs, mapping the left vertices to the right ones, and their number.
+// Time complexity: O(E * sqrt(V)) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) MaximumBipartiteMatching(left []I, right []I) (map[I]I, int) {
+	// Position of every vertex within its partition, or -1 if it's not in the partition
+	leftPos := make([]int, len(g.vertices))
+	rightPos := make([]int, len(g.vertices))
+	for i := range g.vertices {
+		leftPos[i], rightPos[i] = -1, -1
+	}
+	var leftIdxs, rightIdxs []int
+	for _, id := range left {
+		if idx, exists := g.idToIndex[id]; exists && leftPos[idx] < 0 {
+			leftPos[idx] = len(leftIdxs)
+			leftIdxs = append(leftIdxs, idx)
+		}
+	}
+	for _, id := range right {
+		if idx, exists := g.idToIndex[id]; exists && leftPos[idx] < 0 && rightPos[idx] < 0 {
+			rightPos[idx] = len(rightIdxs)
+			rightIdxs = append(rightIdxs, idx)
+		}
+	}
+
+	adjacency := g.undirectedAdjacency()
+	leftAdjacency := make([][]int, len(leftIdxs))
+	for u, idx := range leftIdxs {
+		for _, neighborIdx := range adjacency[idx] {
+			if rightPos[neighborIdx] >= 0 {
+				leftAdjacency[u] = append(leftAdjacency[u], rightPos[neighborIdx])
+			}
+		}
+	}
+
+	// The matched partner of every left and right vertex, or -1 if it's free
+	matchLeft := make([]int, len(leftIdxs))
+	matchRight := make([]int, len(rightIdxs))
+	for u := range matchLeft {
+		matchLeft[u] = -1
+	}
+	for r := range matchRight {
+		matchRight[r] = -1
+	}
+	const unreachable = -1
+	layer := make([]int, len(leftIdxs))
+	nextEdge := make([]int, len(leftIdxs))
+	queue := make([]int, 0, len(leftIdxs))
+	var stack []int
+	size := 0
+
+	for {
+		// Layer the left vertices by the length of the shortest alternating path from
+		// a free left vertex, stopping at the layer where a free right vertex is found
+		queue = queue[:0]
+		for u := range leftIdxs {
+			if matchLeft[u] < 0 {
+				layer[u] = 0
+				queue = append(queue, u)
+			} else {
+				layer[u] = unreachable
+			}
+		}
+		found := false
+		for head := 0; head < len(queue) && !found; head++ {
+			u := queue[head]
+			for _, r := range leftAdjacency[u] {
+				w := matchRight[r]
+				if w < 0 {
+					found = true
+				} else if layer[w] == unreachable {
+					layer[w] = layer[u] + 1
+					queue = append(queue, w)
+				}
+			}
+		}
+		if !found {
+			break
+		}
+
+		// Augment along vertex-disjoint shortest paths following the layers
+		for u := range nextEdge {
+			nextEdge[u] = 0
+		}
+		for root := range leftIdxs {
+			if matchLeft[root] >= 0 {
+				continue
+			}
+			stack = append(stack[:0], root)
+			for len(stack) > 0 {
+				u := stack[len(stack)-1]
+				if nextEdge[u] == len(leftAdjacency[u]) {
+					layer[u] = unreachable // Dead end, don't visit it again in this phase
+					stack = stack[:len(stack)-1]
+					continue
+				}
+				r := leftAdjacency[u][nextEdge[u]]
+				nextEdge[u]++
+				w := matchRight[r]
+				if w >= 0 {
+					if layer[w] == layer[u]+1 {
+						stack = append(stack, w)
+					}
+					continue
+				}
+				// A free right vertex is reached: flip the path, the last tried edge of
+				// every vertex on the stack is the one leading along the path
+				for _, v := range stack {
+					pathR := leftAdjacency[v][nextEdge[v]-1]
+					matchLeft[v] = pathR
+					matchRight[pathR] = v
+					layer[v] = unreachable
+				}
+				size++
+				break
+			}
+		}
+	}
+
+	matching := make(map[I]I, size)
+	for u, r := range matchLeft {
+		if r >= 0 {
+			matching[g.vertices[leftIdxs[u]].id] = g.vertices[rightIdxs[r]].id
+		}
+	}
+	return matching, size
+}
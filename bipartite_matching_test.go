@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphMaximumBipartiteMatching(t *testing.T) {
+	checkMatching := func(t *testing.T, graph *Graph[string, float64, string, string], matching map[string]string) {
+		usedRight := make(map[string]bool)
+		for l, r := range matching {
+			if usedRight[r] {
+				t.Errorf("Expected %s to be matched once, got it matched again with %s", r, l)
+			}
+			usedRight[r] = true
+			lv, _ := graph.GetVertexById(l)
+			rv, _ := graph.GetVertexById(r)
+			connected := false
+			for _, edge := range lv.GetEdges() {
+				connected = connected || edge.GetTargetVertex() == rv
+			}
+			for _, edge := range rv.GetEdges() {
+				connected = connected || edge.GetTargetVertex() == lv
+			}
+			if !connected {
+				t.Errorf("Expected an edge between %s and %s", l, r)
+			}
+		}
+	}
+
+	t.Run("Workers and tasks", func(t *testing.T) {
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddEdge("alice", "cook", 1, "edge")
+		builder.AddEdge("alice", "drive", 1, "edge")
+		builder.AddEdge("bob", "cook", 1, "edge")
+		builder.AddEdge("carol", "cook", 1, "edge")
+		builder.AddEdge("carol", "paint", 1, "edge")
+		builder.AddEdge("dave", "paint", 1, "edge")
+		// An edge in the opposite direction counts as well
+		builder.AddEdge("sing", "dave", 1, "edge")
+
+		graph := builder.BuildDirected()
+		matching, size := graph.MaximumBipartiteMatching(
+			[]string{"alice", "bob", "carol", "dave"},
+			[]string{"cook", "drive", "paint", "sing"},
+		)
+
+		if size != 4 || len(matching) != 4 {
+			t.Errorf("Expected a matching of size 4, got %d (%v)", size, matching)
+		}
+		checkMatching(t, graph, matching)
+	})
+
+	t.Run("Greedy choice must be undone", func(t *testing.T) {
+		// Matching 1 with a first leaves 2 without a partner unless it's augmented
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddBiEdge("1", "a", 1, "edge")
+		builder.AddBiEdge("1", "b", 1, "edge")
+		builder.AddBiEdge("2", "a", 1, "edge")
+		builder.AddBiEdge("3", "a", 1, "edge")
+
+		graph := builder.BuildDirected()
+		matching, size := graph.MaximumBipartiteMatching([]string{"1", "2", "3"}, []string{"a", "b"})
+
+		if size != 2 {
+			t.Errorf("Expected a matching of size 2, got %d (%v)", size, matching)
+		}
+		if matching["1"] != "b" {
+			t.Errorf("Expected 1 to be matched with b, got %q", matching["1"])
+		}
+		checkMatching(t, graph, matching)
+	})
+
+	t.Run("Edges within a partition are ignored", func(t *testing.T) {
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddEdge("1", "2", 1, "edge")
+		builder.AddEdge("a", "b", 1, "edge")
+		builder.AddEdge("2", "b", 1, "edge")
+
+		graph := builder.BuildDirected()
+		matching, size := graph.MaximumBipartiteMatching([]string{"1", "2"}, []string{"a", "b", "x"})
+
+		if size != 1 || matching["2"] != "b" {
+			t.Errorf("Expected the matching {2: b}, got %v", matching)
+		}
+	})
+
+	t.Run("Matches brute force on random graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 30; seed++ {
+			graph := GenerateRandom(12, 0.2, seed,
+				func(origin int, target int) float64 { return 1 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			left := []int{0, 1, 2, 3, 4, 5}
+			right := []int{6, 7, 8, 9, 10, 11}
+			_, size := graph.MaximumBipartiteMatching(left, right)
+
+			// Try every subset of the right vertices for every left vertex
+			connected := func(l int, r int) bool {
+				for _, pair := range [][2]int{{l, r}, {r, l}} {
+					vertex, _ := graph.GetVertexById(pair[0])
+					for _, edge := range vertex.GetEdges() {
+						if edge.GetTargetVertex().GetId() == pair[1] {
+							return true
+						}
+					}
+				}
+				return false
+			}
+			var best func(u int, used int) int
+			best = func(u int, used int) int {
+				if u == len(left) {
+					return 0
+				}
+				result := best(u+1, used)
+				for j, r := range right {
+					if used&(1<<j) == 0 && connected(left[u], r) {
+						if s := 1 + best(u+1, used|1<<j); s > result {
+							result = s
+						}
+					}
+				}
+				return result
+			}
+			if expected := best(0, 0); size != expected {
+				t.Errorf("Seed %d: expected size %d, got %d", seed, expected, size)
+			}
+		}
+	})
+}
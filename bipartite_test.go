@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestBipartition(t *testing.T) {
+	// assertValidBipartition checks that no edge connects two vertices of the same set
+	assertValidBipartition := func(t *testing.T, graph *Graph[int, float64, string, string], left, right []int) {
+		side := map[int]int{}
+		for _, id := range left {
+			side[id] = 1
+		}
+		for _, id := range right {
+			if side[id] != 0 {
+				t.Errorf("Vertex %d is in both sets", id)
+			}
+			side[id] = 2
+		}
+		if len(side) != graph.GetVertexCount() {
+			t.Errorf("Expected %d vertices in the partition, got %d", graph.GetVertexCount(), len(side))
+		}
+		graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			if side[vertex.GetId()] == side[edge.GetTargetVertex().GetId()] {
+				t.Errorf("Edge %d -> %d connects vertices of the same set", vertex.GetId(), edge.GetTargetVertex().GetId())
+			}
+		})
+	}
+
+	t.Run("Even cycle is bipartite", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+		left, right, ok := graph.Bipartition()
+
+		if !ok {
+			t.Fatal("Expected even cycle to be bipartite")
+		}
+
+		if len(left) != 2 || len(right) != 2 {
+			t.Errorf("Expected two sets of 2 vertices, got %v and %v", left, right)
+		}
+
+		assertValidBipartition(t, graph, left, right)
+	})
+
+	t.Run("Odd cycle is not bipartite", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		left, right, ok := graph.Bipartition()
+
+		if ok || left != nil || right != nil {
+			t.Errorf("Expected odd cycle not to be bipartite, got %v and %v", left, right)
+		}
+	})
+
+	t.Run("Odd cycle with opposite edge directions", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+
+		if _, _, ok := graph.Bipartition(); ok {
+			t.Error("Expected edges to be treated as undirected")
+		}
+	})
+
+	t.Run("Forest is bipartite", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(1, 3, 1.0, "edge1-3")
+		builder.AddBiEdge(3, 4, 1.0, "edge3-4")
+		builder.AddBiEdge(5, 6, 1.0, "edge5-6")
+		builder.AddVertex(7, "isolated")
+
+		graph := builder.BuildDirected()
+		left, right, ok := graph.Bipartition()
+
+		if !ok {
+			t.Fatal("Expected forest to be bipartite")
+		}
+
+		assertValidBipartition(t, graph, left, right)
+	})
+}
@@ -0,0 +1,32 @@
+package graph
+
+import "sort"
+
+// BranchLessFunc reports whether edge a should be expanded before edge b when
+// the traversal algorithms limit branching (see DFS.MaxBranch and BFS.MaxBranch).
+type BranchLessFunc[I Id, C Cost] func(a *Edge[I, C], b *Edge[I, C]) bool
+
+// selectBranches fills the buffer with the indexes of the edges a traversal should
+// expand, in expansion order, and returns it.
+// If maxBranch is positive, only the maxBranch best edges according to the
+// comparator are selected (the cheapest ones if the comparator is nil).
+// Otherwise all edges are selected in their original order.
+func selectBranches[I Id, C Cost](edges []Edge[I, C], maxBranch int, less BranchLessFunc[I, C], buf []int) []int {
+	buf = buf[:0]
+	for i := range edges {
+		buf = append(buf, i)
+	}
+	if maxBranch <= 0 {
+		return buf
+	}
+	sort.SliceStable(buf, func(a, b int) bool {
+		if less == nil {
+			return edges[buf[a]].cost < edges[buf[b]].cost
+		}
+		return less(&edges[buf[a]], &edges[buf[b]])
+	})
+	if len(buf) > maxBranch {
+		buf = buf[:maxBranch]
+	}
+	return buf
+}
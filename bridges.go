@@ -0,0 +1,46 @@
+package graph
+
+// Bridges finds the bridges (cut edges) of the graph, i.e. the edges whose removal
+// increases the number of connected components.
+// The graph is treated as undirected, and all directed edges connecting the same
+// pair of vertices (in either direction) are considered a single undirected edge,
+// just like in GetAllBiEdges. So a pair connected with AddBiEdge is reported once.
+// Each bridge is reported with the origin, target, cost and custom data of one of
+// the directed edges it consists of.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) Bridges() []EdgeDto[I, C, E] {
+	discovery, lowLink, parent := undirectedLowLink(g.undirectedAdjacency())
+
+	var bridges []EdgeDto[I, C, E]
+	for i := range g.vertices {
+		parentIdx := parent[i]
+		// A tree edge is a bridge if the child's subtree can't reach the parent otherwise
+		if parentIdx < 0 || lowLink[i] <= discovery[parentIdx] {
+			continue
+		}
+		origin, edge := g.findEdgeBetween(parentIdx, i)
+		bridges = append(bridges, &BasicEdgeDto[I, C, E]{
+			Origin: origin.id,
+			Target: edge.targetVertex.id,
+			Cost:   edge.cost,
+			Data:   g.customEdgeData[edge.customDataIndex],
+		})
+	}
+	return bridges
+}
+
+// findEdgeBetween returns the first directed edge connecting the vertices at the
+// given indexes in either direction, along with its origin vertex.
+// Returns nil values if the vertices aren't adjacent.
+func (g *Graph[I, C, V, E]) findEdgeBetween(aIdx int, bIdx int) (*Vertex[I, C], *Edge[I, C]) {
+	for _, pair := range [2][2]int{{aIdx, bIdx}, {bIdx, aIdx}} {
+		origin := &g.vertices[pair[0]]
+		for j := range origin.edges {
+			if origin.edges[j].targetVertex.GetCustomDataIndex() == pair[1] {
+				return origin, &origin.edges[j]
+			}
+		}
+	}
+	return nil, nil
+}
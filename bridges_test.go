@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestBridges(t *testing.T) {
+	t.Run("Every edge of a tree is a bridge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(1, 3, 2.0, "edge1-3")
+		builder.AddBiEdge(3, 4, 3.0, "edge3-4")
+		builder.AddBiEdge(3, 5, 4.0, "edge3-5")
+
+		graph := builder.BuildDirected()
+		bridges := graph.Bridges()
+
+		if len(bridges) != 4 {
+			t.Fatalf("Expected 4 bridges, got %d", len(bridges))
+		}
+
+		expectedCosts := map[string]float64{"edge1-2": 1.0, "edge1-3": 2.0, "edge3-4": 3.0, "edge3-5": 4.0}
+		for _, bridge := range bridges {
+			cost, ok := expectedCosts[bridge.GetData()]
+			if !ok {
+				t.Errorf("Unexpected or duplicate bridge %v -> %v", bridge.GetOrigin(), bridge.GetTarget())
+				continue
+			}
+			if bridge.GetCost() != cost {
+				t.Errorf("Expected cost %f for %s, got %f", cost, bridge.GetData(), bridge.GetCost())
+			}
+			delete(expectedCosts, bridge.GetData())
+		}
+	})
+
+	t.Run("No edge of a cycle is a bridge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+		bridges := graph.Bridges()
+
+		if len(bridges) != 0 {
+			t.Errorf("Expected no bridges, got %d", len(bridges))
+		}
+	})
+
+	t.Run("Handle between two cycles", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(3, 4, 7.0, "edge3-4")
+		builder.AddBiEdge(4, 5, 1.0, "edge4-5")
+		builder.AddBiEdge(5, 6, 1.0, "edge5-6")
+		builder.AddBiEdge(6, 4, 1.0, "edge6-4")
+
+		graph := builder.BuildDirected()
+		bridges := graph.Bridges()
+
+		if len(bridges) != 1 {
+			t.Fatalf("Expected 1 bridge, got %d", len(bridges))
+		}
+
+		if bridges[0].GetOrigin() != 3 || bridges[0].GetTarget() != 4 || bridges[0].GetCost() != 7.0 {
+			t.Errorf("Expected bridge 3 -> 4 with cost 7, got %v -> %v with cost %f",
+				bridges[0].GetOrigin(), bridges[0].GetTarget(), bridges[0].GetCost())
+		}
+	})
+}
@@ -1,5 +1,7 @@
 package graph
 
+import "sort"
+
 // Constants defining the bulk sizes for efficient memory allocation
 const edgeBulkSize = 1000   // Number of edges to allocate in each bulk
 const vertexBulkSize = 1000 // Number of vertices to allocate in each bulk
@@ -28,25 +30,102 @@ type Builder[I Id, C Cost, V any, E any] struct {
 	firstVertexBulk     *vertexBulk[I, V]  // First bulk in the vertex bulk chain
 	vertexCount         int                // Total number of vertices added
 	freeVertexSlotCount int                // Number of free slots in the current vertex bulk
+	dedupeEdges         bool               // Whether duplicate edges are collapsed by BuildDirected
+	keepEdge            EdgeKeepFunc[I, C, E]
+	sortEdges           bool // Whether BuildDirected sorts the edges of every vertex by target ID
 }
 
+// EdgeKeepFunc decides which of two edges connecting the same ordered vertex pair is kept
+// when the Builder collapses duplicate edges. The existing edge is the one added earlier.
+// It may return either of them or a new EdgeDto, e.g. with the minimum cost.
+type EdgeKeepFunc[I Id, C Cost, E any] func(existing EdgeDto[I, C, E], incoming EdgeDto[I, C, E]) EdgeDto[I, C, E]
+
 // AddEdgeDto adds a directed edge using an EdgeDto.
 // Automatically allocates new bulks when the current one is full.
 // This method is the primary way to add edges to the builder.
 func (b *Builder[I, C, V, E]) AddEdgeDto(dto EdgeDto[I, C, E]) {
-	if b.freeEdgeSlotCount == 0 {
-		newEdgeBulk := &edgeBulk[I, C, E]{
-			edges: make([]EdgeDto[I, C, E], 0, edgeBulkSize),
-			next:  b.firstEdgeBulk,
-		}
-		b.firstEdgeBulk = newEdgeBulk
-		b.freeEdgeSlotCount = edgeBulkSize
-	}
+	b.reserveEdgeSlots(1)
 	b.firstEdgeBulk.edges = append(b.firstEdgeBulk.edges, dto)
 	b.freeEdgeSlotCount--
 	b.edgeCount++
 }
 
+// AddEdges adds directed edges from a slice of EdgeDto in one call.
+// The whole slice is appended to a single bulk, which is allocated with a sufficient
+// capacity if the current one doesn't have enough free slots.
+// This is faster than calling AddEdgeDto in a loop for large graphs.
+// An empty or nil slice is a no-op.
+func (b *Builder[I, C, V, E]) AddEdges(dtos []EdgeDto[I, C, E]) {
+	if len(dtos) == 0 {
+		return
+	}
+	b.reserveEdgeSlots(len(dtos))
+	b.firstEdgeBulk.edges = append(b.firstEdgeBulk.edges, dtos...)
+	b.freeEdgeSlotCount -= len(dtos)
+	b.edgeCount += len(dtos)
+}
+
+// AddBiEdges adds two directed edges in both directions for every EdgeDto of the slice
+// in one call, like AddBiEdge does for a single edge.
+// The reverse edges are created as BasicEdgeDto with the same cost and data.
+// An empty or nil slice is a no-op.
+func (b *Builder[I, C, V, E]) AddBiEdges(dtos []EdgeDto[I, C, E]) {
+	if len(dtos) == 0 {
+		return
+	}
+	b.reserveEdgeSlots(2 * len(dtos))
+	for _, dto := range dtos {
+		b.firstEdgeBulk.edges = append(b.firstEdgeBulk.edges, dto, &BasicEdgeDto[I, C, E]{
+			dto.GetTarget(), dto.GetOrigin(), dto.GetCost(), dto.GetData(),
+		})
+	}
+	b.freeEdgeSlotCount -= 2 * len(dtos)
+	b.edgeCount += 2 * len(dtos)
+}
+
+// Reserve is a hint that pre-sizes the builder for the given number of vertices and
+// edges that are going to be added, so that they fit into a single bulk each instead
+// of a chain of incrementally allocated ones. The free slots left in the current bulks
+// are abandoned if they aren't enough.
+func (b *Builder[I, C, V, E]) Reserve(vertexCount int, edgeCount int) {
+	b.reserveVertexSlots(vertexCount)
+	b.reserveEdgeSlots(edgeCount)
+}
+
+// reserveEdgeSlots makes sure the current edge bulk has at least the given number of
+// free slots, allocating a new bulk of at least edgeBulkSize slots otherwise.
+func (b *Builder[I, C, V, E]) reserveEdgeSlots(count int) {
+	if b.freeEdgeSlotCount >= count {
+		return
+	}
+	size := edgeBulkSize
+	if count > size {
+		size = count
+	}
+	b.firstEdgeBulk = &edgeBulk[I, C, E]{
+		edges: make([]EdgeDto[I, C, E], 0, size),
+		next:  b.firstEdgeBulk,
+	}
+	b.freeEdgeSlotCount = size
+}
+
+// reserveVertexSlots makes sure the current vertex bulk has at least the given number
+// of free slots, allocating a new bulk of at least vertexBulkSize slots otherwise.
+func (b *Builder[I, C, V, E]) reserveVertexSlots(count int) {
+	if b.freeVertexSlotCount >= count {
+		return
+	}
+	size := vertexBulkSize
+	if count > size {
+		size = count
+	}
+	b.firstVertexBulk = &vertexBulk[I, V]{
+		vertices: make([]VertexDto[I, V], 0, size),
+		next:     b.firstVertexBulk,
+	}
+	b.freeVertexSlotCount = size
+}
+
 // AddEdge adds a directed edge with the specified parameters.
 // Creates a BasicEdgeDto internally and calls AddEdgeDto.
 // This is a convenience method for adding edges without creating DTOs manually.
@@ -73,19 +152,61 @@ func (b *Builder[I, C, V, E]) AddVertex(id I, data V) {
 // Automatically allocates new bulks when the current one is full.
 // This method is the primary way to add vertices to the builder.
 func (b *Builder[I, C, V, E]) AddVertexDto(dto VertexDto[I, V]) {
-	if b.freeVertexSlotCount == 0 {
-		newVertexBulk := &vertexBulk[I, V]{
-			vertices: make([]VertexDto[I, V], 0, vertexBulkSize),
-			next:     b.firstVertexBulk,
-		}
-		b.firstVertexBulk = newVertexBulk
-		b.freeVertexSlotCount = vertexBulkSize
-	}
+	b.reserveVertexSlots(1)
 	b.firstVertexBulk.vertices = append(b.firstVertexBulk.vertices, dto)
 	b.freeVertexSlotCount--
 	b.vertexCount++
 }
 
+// DedupeEdges makes BuildDirected collapse the edges connecting the same ordered pair
+// of vertices (origin, target) into a single edge, which is handy for importing noisy
+// data. The keep callback is called for every duplicate in the order the edges were
+// added and decides which cost and data to keep. If the callback is nil, the edge
+// added first is kept. The collapsed edge takes the position of the first one.
+func (b *Builder[I, C, V, E]) DedupeEdges(keep EdgeKeepFunc[I, C, E]) {
+	b.dedupeEdges = true
+	b.keepEdge = keep
+}
+
+// SortEdges makes BuildDirected sort the outgoing edges of every vertex by target ID,
+// so the traversal order and the results depending on it (paths, cycles etc.) don't
+// depend on the order the edges were added in, which makes them reproducible for
+// testing and caching. Parallel edges keep the order they were added in.
+// NOTE: The vertex indexes still follow the order the vertices were first seen in,
+// so the algorithms iterating over all the vertices may still depend on it.
+func (b *Builder[I, C, V, E]) SortEdges() {
+	b.sortEdges = true
+}
+
+// collapseDuplicateEdges replaces the edge bulk chain with a single bulk where each
+// ordered vertex pair is connected by at most one edge (see DedupeEdges).
+func (b *Builder[I, C, V, E]) collapseDuplicateEdges() {
+	// The chain starts with the newest bulk, so it's reversed to keep the insertion order
+	var bulks []*edgeBulk[I, C, E]
+	for bulk := b.firstEdgeBulk; bulk != nil; bulk = bulk.next {
+		bulks = append(bulks, bulk)
+	}
+
+	edges := make([]EdgeDto[I, C, E], 0, b.edgeCount)
+	positions := make(map[EdgeKey[I]]int, b.edgeCount)
+	for i := len(bulks) - 1; i >= 0; i-- {
+		for _, dto := range bulks[i].edges {
+			key := EdgeKey[I]{Origin: dto.GetOrigin(), Target: dto.GetTarget()}
+			position, exists := positions[key]
+			if !exists {
+				positions[key] = len(edges)
+				edges = append(edges, dto)
+			} else if b.keepEdge != nil {
+				edges[position] = b.keepEdge(edges[position], dto)
+			}
+		}
+	}
+
+	b.firstEdgeBulk = &edgeBulk[I, C, E]{edges: edges}
+	b.freeEdgeSlotCount = cap(edges) - len(edges)
+	b.edgeCount = len(edges)
+}
+
 // biEdgeKey is used for tracking unique bidirectional edges.
 // Ensures consistent ordering of vertex pairs for deduplication.
 type biEdgeKey[I Id] struct{ origin, target I }
@@ -146,8 +267,13 @@ func (b *Builder[I, C, V, E]) countOutgoingEdges() map[I]int {
 // This method should only be called once per builder instance.
 // It's unsafe to call multiple times as graphs would share data structures.
 // Use Graph.Clone() to create multiple instances of the same graph.
+// Duplicate edges are collapsed if DedupeEdges has been called, and the edges are
+// sorted by target ID if SortEdges has been called.
 // Returns a fully constructed Graph with all vertices and edges.
 func (b *Builder[I, C, V, E]) BuildDirected() *Graph[I, C, V, E] {
+	if b.dedupeEdges {
+		b.collapseDuplicateEdges()
+	}
 	vertexCount := b.predictVertexArrayLength()
 	g := &Graph[I, C, V, E]{
 		vertices:         make([]Vertex[I, C], vertexCount),
@@ -205,5 +331,13 @@ func (b *Builder[I, C, V, E]) BuildDirected() *Graph[I, C, V, E] {
 			g.customVertexData[originIdx] = bulk.vertices[i].GetData()
 		}
 	}
+	if b.sortEdges {
+		for i := range g.vertices {
+			edges := g.vertices[i].edges
+			sort.SliceStable(edges, func(a, b int) bool {
+				return edges[a].targetVertex.id < edges[b].targetVertex.id
+			})
+		}
+	}
 	return g
 }
@@ -242,3 +242,269 @@ func TestBuilder(t *testing.T) {
 		}
 	})
 }
+
+func TestBuilderBatch(t *testing.T) {
+	t.Run("Add edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, bool]{}
+		builder.AddEdge(1, 2, 1.0, true)
+		builder.AddEdges([]EdgeDto[int, float64, bool]{
+			&BasicEdgeDto[int, float64, bool]{Origin: 2, Target: 3, Cost: 2.0, Data: true},
+			&BasicEdgeDto[int, float64, bool]{Origin: 3, Target: 1, Cost: 3.0, Data: false},
+		})
+
+		if builder.edgeCount != 3 {
+			t.Errorf("Expected edge count 3, got %d", builder.edgeCount)
+		}
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != 3 || graph.GetVertexCount() != 3 {
+			t.Errorf("Expected 3 vertices and 3 edges, got %d and %d", graph.GetVertexCount(), graph.GetEdgeCount())
+		}
+		vertex, _ := graph.GetVertexById(3)
+		edges := vertex.GetEdges()
+		if len(edges) != 1 || edges[0].GetTargetVertex().GetId() != 1 || edges[0].GetCost() != 3.0 {
+			t.Errorf("Expected edge 3->1 with cost 3, got %v", edges)
+		}
+	})
+
+	t.Run("Add bidirectional edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, bool]{}
+		builder.AddBiEdges([]EdgeDto[int, float64, bool]{
+			&BasicEdgeDto[int, float64, bool]{Origin: 1, Target: 2, Cost: 2.0, Data: true},
+			&BasicEdgeDto[int, float64, bool]{Origin: 2, Target: 3, Cost: 3.0, Data: false},
+		})
+
+		if builder.edgeCount != 4 {
+			t.Errorf("Expected edge count 4, got %d", builder.edgeCount)
+		}
+		graph := builder.BuildDirected()
+		if graph.GetBiEdgeCount() != 2 {
+			t.Errorf("Expected 2 bidirectional edges, got %d", graph.GetBiEdgeCount())
+		}
+		vertex, _ := graph.GetVertexById(3)
+		edges := vertex.GetEdges()
+		if len(edges) != 1 || edges[0].GetTargetVertex().GetId() != 2 || edges[0].GetCost() != 3.0 {
+			t.Errorf("Expected edge 3->2 with cost 3, got %v", edges)
+		}
+		if data, _ := graph.GetEdgeData(&edges[0]); *data != false {
+			t.Errorf("Expected edge data false, got %v", *data)
+		}
+	})
+
+	t.Run("Empty batches", func(t *testing.T) {
+		builder := &Builder[int, float64, string, bool]{}
+		builder.AddEdges(nil)
+		builder.AddEdges([]EdgeDto[int, float64, bool]{})
+		builder.AddBiEdges(nil)
+		builder.AddBiEdges([]EdgeDto[int, float64, bool]{})
+
+		if builder.edgeCount != 0 {
+			t.Errorf("Expected edge count 0, got %d", builder.edgeCount)
+		}
+		builder.AddEdge(1, 2, 1.0, true)
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != 1 || graph.GetVertexCount() != 2 {
+			t.Errorf("Expected 2 vertices and 1 edge, got %d and %d", graph.GetVertexCount(), graph.GetEdgeCount())
+		}
+	})
+
+	t.Run("Batches larger than a bulk", func(t *testing.T) {
+		builder := &Builder[int, float64, string, bool]{}
+		builder.AddEdge(0, 1, 1.0, true)
+		dtos := make([]EdgeDto[int, float64, bool], 2*edgeBulkSize)
+		for i := range dtos {
+			dtos[i] = &BasicEdgeDto[int, float64, bool]{Origin: i, Target: i + 1, Cost: 1.0, Data: true}
+		}
+		builder.AddEdges(dtos)
+		builder.AddEdge(0, 2, 1.0, true)
+
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != 2*edgeBulkSize+2 {
+			t.Errorf("Expected %d edges, got %d", 2*edgeBulkSize+2, graph.GetEdgeCount())
+		}
+		if graph.GetVertexCount() != 2*edgeBulkSize+1 {
+			t.Errorf("Expected %d vertices, got %d", 2*edgeBulkSize+1, graph.GetVertexCount())
+		}
+	})
+
+	t.Run("Reserve", func(t *testing.T) {
+		builder := &Builder[int, float64, string, bool]{}
+		builder.Reserve(3000, 5000)
+
+		for i := 0; i < 3000; i++ {
+			builder.AddVertex(i, "vertex")
+		}
+		for i := 0; i < 5000; i++ {
+			builder.AddEdge(i%3000, (i+1)%3000, 1.0, true)
+		}
+
+		if builder.firstVertexBulk.next != nil || builder.firstEdgeBulk.next != nil {
+			t.Errorf("Expected a single vertex bulk and a single edge bulk")
+		}
+		graph := builder.BuildDirected()
+		if graph.GetVertexCount() != 3000 || graph.GetEdgeCount() != 5000 {
+			t.Errorf("Expected 3000 vertices and 5000 edges, got %d and %d", graph.GetVertexCount(), graph.GetEdgeCount())
+		}
+	})
+}
+
+func TestBuilderDedupeEdges(t *testing.T) {
+	t.Run("Keep the minimum cost", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "expensive")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 2, 3.0, "cheap")
+		builder.DedupeEdges(func(existing, incoming EdgeDto[int, float64, string]) EdgeDto[int, float64, string] {
+			if incoming.GetCost() < existing.GetCost() {
+				return incoming
+			}
+			return existing
+		})
+
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != 2 {
+			t.Errorf("Expected 2 edges, got %d", graph.GetEdgeCount())
+		}
+		vertex, _ := graph.GetVertexById(1)
+		edges := vertex.GetEdges()
+		if len(edges) != 1 || edges[0].GetCost() != 3.0 {
+			t.Fatalf("Expected a single edge 1->2 with cost 3, got %v", edges)
+		}
+		if data, _ := graph.GetEdgeData(&edges[0]); *data != "cheap" {
+			t.Errorf("Expected edge data cheap, got %s", *data)
+		}
+	})
+
+	t.Run("Keep the first edge by default", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "first")
+		builder.AddEdge(1, 2, 3.0, "second")
+		builder.AddEdge(2, 1, 3.0, "reverse")
+		builder.DedupeEdges(nil)
+
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != 2 || graph.GetBiEdgeCount() != 1 {
+			t.Errorf("Expected 2 edges and 1 bidirectional edge, got %d and %d", graph.GetEdgeCount(), graph.GetBiEdgeCount())
+		}
+		vertex, _ := graph.GetVertexById(1)
+		edges := vertex.GetEdges()
+		if len(edges) != 1 || edges[0].GetCost() != 5.0 {
+			t.Fatalf("Expected a single edge 1->2 with cost 5, got %v", edges)
+		}
+		if data, _ := graph.GetEdgeData(&edges[0]); *data != "first" {
+			t.Errorf("Expected edge data first, got %s", *data)
+		}
+	})
+
+	t.Run("Insertion order across bulks", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "first")
+		for i := 0; i < edgeBulkSize; i++ {
+			builder.AddEdge(10+i, 11+i, 1.0, "filler")
+		}
+		builder.AddEdge(1, 2, 2.0, "last")
+		builder.DedupeEdges(nil)
+
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != edgeBulkSize+1 {
+			t.Errorf("Expected %d edges, got %d", edgeBulkSize+1, graph.GetEdgeCount())
+		}
+		vertex, _ := graph.GetVertexById(1)
+		edges := vertex.GetEdges()
+		if len(edges) != 1 {
+			t.Fatalf("Expected a single edge 1->2, got %v", edges)
+		}
+		if data, _ := graph.GetEdgeData(&edges[0]); *data != "first" {
+			t.Errorf("Expected edge data first, got %s", *data)
+		}
+	})
+
+	t.Run("Duplicates are kept without the option", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "first")
+		builder.AddEdge(1, 2, 3.0, "second")
+
+		graph := builder.BuildDirected()
+		if graph.GetEdgeCount() != 2 {
+			t.Errorf("Expected 2 edges, got %d", graph.GetEdgeCount())
+		}
+	})
+}
+
+func TestBuilderSortEdges(t *testing.T) {
+	edges := []BasicEdgeDto[int, float64, string]{
+		{Origin: 1, Target: 4, Cost: 1, Data: "edge1-4"},
+		{Origin: 1, Target: 2, Cost: 1, Data: "edge1-2"},
+		{Origin: 1, Target: 3, Cost: 1, Data: "edge1-3"},
+		{Origin: 2, Target: 5, Cost: 1, Data: "edge2-5"},
+		{Origin: 3, Target: 5, Cost: 1, Data: "edge3-5"},
+		{Origin: 4, Target: 6, Cost: 1, Data: "edge4-6"},
+		{Origin: 5, Target: 6, Cost: 1, Data: "edge5-6"},
+		{Origin: 5, Target: 1, Cost: 1, Data: "edge5-1"},
+	}
+	build := func(order []int) *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		for _, i := range order {
+			builder.AddEdge(edges[i].Origin, edges[i].Target, edges[i].Cost, edges[i].Data)
+		}
+		builder.SortEdges()
+		return builder.BuildDirected()
+	}
+	first := build([]int{0, 1, 2, 3, 4, 5, 6, 7})
+	second := build([]int{7, 6, 5, 4, 3, 2, 1, 0})
+
+	t.Run("Edges are sorted by target", func(t *testing.T) {
+		vertex, _ := second.GetVertexById(1)
+		var targets []int
+		for _, edge := range vertex.GetEdges() {
+			targets = append(targets, edge.GetTargetVertex().GetId())
+		}
+		if !slicesEqual(targets, []int{2, 3, 4}) {
+			t.Errorf("Expected targets [2 3 4], got %v", targets)
+		}
+		if data, _ := second.GetEdgeData(&vertex.GetEdges()[0]); *data != "edge1-2" {
+			t.Errorf("Expected edge data edge1-2, got %s", *data)
+		}
+	})
+
+	t.Run("Identical traversals", func(t *testing.T) {
+		firstDFS := NewDFS(first).GetAllReachable(1)
+		secondDFS := NewDFS(second).GetAllReachable(1)
+		if !slicesEqual(firstDFS, secondDFS) {
+			t.Errorf("Expected identical DFS orders, got %v and %v", firstDFS, secondDFS)
+		}
+
+		var firstBFS, secondBFS []int
+		NewBFS(first).TraverseFrom(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			firstBFS = append(firstBFS, vertex.GetId())
+		})
+		NewBFS(second).TraverseFrom(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			secondBFS = append(secondBFS, vertex.GetId())
+		})
+		if !slicesEqual(firstBFS, secondBFS) {
+			t.Errorf("Expected identical BFS orders, got %v and %v", firstBFS, secondBFS)
+		}
+
+		firstPath := NewDFS(first).FindPath(1, 6)
+		secondPath := NewDFS(second).FindPath(1, 6)
+		if !slicesEqual(firstPath, secondPath) {
+			t.Errorf("Expected identical paths, got %v and %v", firstPath, secondPath)
+		}
+	})
+
+	t.Run("Parallel edges keep the insertion order", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 3, 1, "first")
+		builder.AddEdge(1, 2, 1, "other")
+		builder.AddEdge(1, 3, 1, "second")
+		builder.SortEdges()
+
+		graph := builder.BuildDirected()
+		vertex, _ := graph.GetVertexById(1)
+		edges := vertex.GetEdges()
+		firstData, _ := graph.GetEdgeData(&edges[1])
+		secondData, _ := graph.GetEdgeData(&edges[2])
+		if *firstData != "first" || *secondData != "second" {
+			t.Errorf("Expected parallel edges first, second, got %s, %s", *firstData, *secondData)
+		}
+	})
+}
@@ -0,0 +1,273 @@
+package graph
+
+import "container/heap"
+
+// BetweennessCentrality computes the betweenness centrality of every vertex using
+// Brandes' algorithm: the sum over all ordered pairs of other vertices (s, t) of the
+// fraction of shortest s-t paths passing through the vertex.
+// If weighted is false, every edge counts as one hop and shortest paths are found
+// with BFS. Otherwise edge costs are used as distances and shortest paths are found
+// with Dijkstra's algorithm, so the costs must be non-negative.
+// If directed is true, paths follow the edge directions. Otherwise the undirected
+// interpretation of the graph is used, where every edge can be traversed both ways.
+// The values aren't normalized and every pair is taken as ordered, so in the
+// undirected mode each path is counted in both directions.
+// Time complexity: O(V * E) unweighted, O(V * E log V) weighted,
+// where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) BetweennessCentrality(weighted bool, directed bool) map[I]float64 {
+	adjacency := g.costAdjacency(directed)
+	vertexCount := len(g.vertices)
+	centrality := make([]float64, vertexCount)
+
+	order := make([]int, 0, vertexCount)       // Vertices in non-decreasing distance order
+	predecessors := make([][]int, vertexCount) // Predecessors on shortest paths
+	sigma := make([]float64, vertexCount)      // Number of shortest paths from the source
+	delta := make([]float64, vertexCount)      // Dependency of the source on each vertex
+	hops := make([]int, vertexCount)
+	distance := make([]C, vertexCount)
+	reached := make([]bool, vertexCount)
+	settled := make([]bool, vertexCount)
+	pq := &costHeap[C]{}
+
+	for source := range g.vertices {
+		for i := range g.vertices {
+			predecessors[i] = predecessors[i][:0]
+			sigma[i] = 0
+			delta[i] = 0
+			reached[i] = false
+			settled[i] = false
+		}
+		order = order[:0]
+		sigma[source] = 1
+		reached[source] = true
+
+		if weighted {
+			// Dijkstra's single-source shortest paths counting
+			distance[source] = 0
+			*pq = (*pq)[:0]
+			heap.Push(pq, costHeapItem[C]{cost: 0, vertexIdx: source})
+			for pq.Len() > 0 {
+				item := heap.Pop(pq).(costHeapItem[C])
+				currentIdx := item.vertexIdx
+				if settled[currentIdx] {
+					continue
+				}
+				settled[currentIdx] = true
+				order = append(order, currentIdx)
+				for _, arc := range adjacency[currentIdx] {
+					neighborIdx := arc.targetIdx
+					if settled[neighborIdx] {
+						continue
+					}
+					tentative := saturatingAdd(distance[currentIdx], arc.cost)
+					if !reached[neighborIdx] || tentative < distance[neighborIdx] {
+						reached[neighborIdx] = true
+						distance[neighborIdx] = tentative
+						sigma[neighborIdx] = sigma[currentIdx]
+						predecessors[neighborIdx] = append(predecessors[neighborIdx][:0], currentIdx)
+						heap.Push(pq, costHeapItem[C]{cost: tentative, vertexIdx: neighborIdx})
+					} else if tentative == distance[neighborIdx] {
+						sigma[neighborIdx] += sigma[currentIdx]
+						predecessors[neighborIdx] = append(predecessors[neighborIdx], currentIdx)
+					}
+				}
+			}
+		} else {
+			// BFS single-source shortest paths counting
+			hops[source] = 0
+			order = append(order, source)
+			for head := 0; head < len(order); head++ {
+				currentIdx := order[head]
+				for _, arc := range adjacency[currentIdx] {
+					neighborIdx := arc.targetIdx
+					if !reached[neighborIdx] {
+						reached[neighborIdx] = true
+						hops[neighborIdx] = hops[currentIdx] + 1
+						order = append(order, neighborIdx)
+					}
+					if hops[neighborIdx] == hops[currentIdx]+1 {
+						sigma[neighborIdx] += sigma[currentIdx]
+						predecessors[neighborIdx] = append(predecessors[neighborIdx], currentIdx)
+					}
+				}
+			}
+		}
+
+		// Accumulate the dependencies in order of non-increasing distance
+		for k := len(order) - 1; k >= 0; k-- {
+			currentIdx := order[k]
+			for _, predecessorIdx := range predecessors[currentIdx] {
+				delta[predecessorIdx] += sigma[predecessorIdx] / sigma[currentIdx] * (1 + delta[currentIdx])
+			}
+			if currentIdx != source {
+				centrality[currentIdx] += delta[currentIdx]
+			}
+		}
+	}
+
+	result := make(map[I]float64, vertexCount)
+	for i := range g.vertices {
+		result[g.vertices[i].id] = centrality[i]
+	}
+	return result
+}
+
+// ClosenessCentrality computes the closeness centrality of every vertex, which is
+// the inverse of the average shortest path distance from the vertex to the others.
+// Only the reachable vertices are taken into account, and the value is scaled by the
+// fraction of the vertices that are reachable (Wasserman and Faust), so vertices
+// reaching only a small part of the graph don't get inflated scores.
+// A vertex that reaches no other vertex has the closeness of zero.
+// If weighted is false, every edge counts as one hop. Otherwise edge costs are used
+// as distances, so they must be non-negative.
+// If directed is true, distances are measured along the outgoing edges. Otherwise the
+// undirected interpretation of the graph is used, where every edge can be traversed
+// both ways, so for example in a directed chain the last vertex reaches nothing in
+// the directed mode but is as close to its neighbors as the first one in the undirected mode.
+// Time complexity: O(V * E) unweighted, O(V * E log V) weighted,
+// where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) ClosenessCentrality(weighted bool, directed bool) map[I]float64 {
+	distances := newShortestDistances(g.costAdjacency(directed), weighted)
+	result := make(map[I]float64, len(g.vertices))
+	for source := range g.vertices {
+		order := distances.compute(source)
+		total := 0.0
+		for _, vertexIdx := range order {
+			total += distances.distanceTo(vertexIdx)
+		}
+		reachable := float64(len(order) - 1)
+		closeness := 0.0
+		if total > 0 {
+			closeness = reachable / total * reachable / float64(len(g.vertices)-1)
+		}
+		result[g.vertices[source].id] = closeness
+	}
+	return result
+}
+
+// Eccentricity computes the eccentricity of every vertex, which is the greatest
+// shortest path distance from the vertex to any vertex reachable from it.
+// Unreachable vertices are ignored, so a vertex that reaches nothing has the
+// eccentricity of zero.
+// If weighted is false, every edge counts as one hop. Otherwise edge costs are used
+// as distances, so they must be non-negative.
+// If directed is true, distances are measured along the outgoing edges. Otherwise the
+// undirected interpretation of the graph is used, where every edge can be traversed both ways.
+// Time complexity: O(V * E) unweighted, O(V * E log V) weighted,
+// where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) Eccentricity(weighted bool, directed bool) map[I]C {
+	distances := newShortestDistances(g.costAdjacency(directed), weighted)
+	result := make(map[I]C, len(g.vertices))
+	for source := range g.vertices {
+		var eccentricity C
+		for _, vertexIdx := range distances.compute(source) {
+			if distance := distances.costTo(vertexIdx); distance > eccentricity {
+				eccentricity = distance
+			}
+		}
+		result[g.vertices[source].id] = eccentricity
+	}
+	return result
+}
+
+// shortestDistances computes single-source shortest path distances over adjacency
+// lists with BFS (in hops) or Dijkstra's algorithm (in costs), reusing its buffers
+// between the calls.
+type shortestDistances[C Cost] struct {
+	adjacency [][]costArc[C]
+	weighted  bool
+	distance  []C   // Distances in costs, used in the weighted mode
+	hops      []int // Distances in hops, used in the unweighted mode
+	reached   []bool
+	settled   []bool
+	order     []int
+	pq        *costHeap[C]
+}
+
+func newShortestDistances[C Cost](adjacency [][]costArc[C], weighted bool) *shortestDistances[C] {
+	return &shortestDistances[C]{
+		adjacency: adjacency,
+		weighted:  weighted,
+		distance:  make([]C, len(adjacency)),
+		hops:      make([]int, len(adjacency)),
+		reached:   make([]bool, len(adjacency)),
+		settled:   make([]bool, len(adjacency)),
+		order:     make([]int, 0, len(adjacency)),
+		pq:        &costHeap[C]{},
+	}
+}
+
+// compute finds the distances from the source to every reachable vertex.
+// Returns the reachable vertex indexes (including the source) in non-decreasing
+// distance order. The slice is only valid until the next call.
+func (s *shortestDistances[C]) compute(source int) []int {
+	for i := range s.reached {
+		s.reached[i] = false
+		s.settled[i] = false
+	}
+	s.order = s.order[:0]
+	s.distance[source] = 0
+	s.hops[source] = 0
+	s.reached[source] = true
+
+	if !s.weighted {
+		s.order = append(s.order, source)
+		for head := 0; head < len(s.order); head++ {
+			currentIdx := s.order[head]
+			for _, arc := range s.adjacency[currentIdx] {
+				if !s.reached[arc.targetIdx] {
+					s.reached[arc.targetIdx] = true
+					s.hops[arc.targetIdx] = s.hops[currentIdx] + 1
+					s.order = append(s.order, arc.targetIdx)
+				}
+			}
+		}
+		return s.order
+	}
+
+	*s.pq = (*s.pq)[:0]
+	heap.Push(s.pq, costHeapItem[C]{cost: 0, vertexIdx: source})
+	for s.pq.Len() > 0 {
+		currentIdx := heap.Pop(s.pq).(costHeapItem[C]).vertexIdx
+		if s.settled[currentIdx] {
+			continue
+		}
+		s.settled[currentIdx] = true
+		s.order = append(s.order, currentIdx)
+		for _, arc := range s.adjacency[currentIdx] {
+			if s.settled[arc.targetIdx] {
+				continue
+			}
+			tentative := saturatingAdd(s.distance[currentIdx], arc.cost)
+			if !s.reached[arc.targetIdx] || tentative < s.distance[arc.targetIdx] {
+				s.reached[arc.targetIdx] = true
+				s.distance[arc.targetIdx] = tentative
+				heap.Push(s.pq, costHeapItem[C]{cost: tentative, vertexIdx: arc.targetIdx})
+			}
+		}
+	}
+	return s.order
+}
+
+// distanceTo returns the distance to a vertex found by the last compute call.
+func (s *shortestDistances[C]) distanceTo(idx int) float64 {
+	if s.weighted {
+		return float64(s.distance[idx])
+	}
+	return float64(s.hops[idx])
+}
+
+// costTo returns the distance to a vertex found by the last compute call in the cost
+// type. Hop counts that don't fit into the cost type are clamped to its maximum.
+func (s *shortestDistances[C]) costTo(idx int) C {
+	if s.weighted {
+		return s.distance[idx]
+	}
+	if limit := maxCost[C](); float64(s.hops[idx]) > float64(limit) {
+		return limit
+	}
+	return C(s.hops[idx])
+}
@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBetweennessCentrality(t *testing.T) {
+	t.Run("Star graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for leaf := 1; leaf <= 4; leaf++ {
+			builder.AddBiEdge(0, leaf, 1.0, "spoke")
+		}
+
+		graph := builder.BuildDirected()
+
+		for _, weighted := range []bool{false, true} {
+			centrality := graph.BetweennessCentrality(weighted, true)
+
+			// All 4 * 3 ordered pairs of leaves pass through the center
+			if centrality[0] != 12 {
+				t.Errorf("Expected center betweenness 12 (weighted=%v), got %f", weighted, centrality[0])
+			}
+
+			for leaf := 1; leaf <= 4; leaf++ {
+				if centrality[leaf] != 0 {
+					t.Errorf("Expected leaf %d betweenness 0 (weighted=%v), got %f", leaf, weighted, centrality[leaf])
+				}
+			}
+		}
+	})
+
+	t.Run("Equal-length paths share the credit", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		centrality := graph.BetweennessCentrality(false, true)
+
+		if math.Abs(centrality[2]-0.5) > 1e-9 || math.Abs(centrality[3]-0.5) > 1e-9 {
+			t.Errorf("Expected betweenness 0.5 for vertices 2 and 3, got %f and %f", centrality[2], centrality[3])
+		}
+	})
+
+	t.Run("Weighted mode follows costs", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(1, 3, 5.0, "edge1-3")
+		builder.AddEdge(3, 4, 5.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		unweighted := graph.BetweennessCentrality(false, true)
+		weighted := graph.BetweennessCentrality(true, true)
+
+		if unweighted[2] != 0.5 || unweighted[3] != 0.5 {
+			t.Errorf("Expected unweighted betweenness 0.5 for vertices 2 and 3, got %f and %f", unweighted[2], unweighted[3])
+		}
+
+		if weighted[2] != 1 || weighted[3] != 0 {
+			t.Errorf("Expected weighted betweenness 1 for vertex 2 and 0 for vertex 3, got %f and %f", weighted[2], weighted[3])
+		}
+	})
+
+	t.Run("Undirected mode on a directed chain", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		directed := graph.BetweennessCentrality(false, true)
+		undirected := graph.BetweennessCentrality(false, false)
+
+		// Only 1 -> 3 passes through 2 in the directed mode, and 3 -> 1 as well otherwise
+		if directed[2] != 1 || undirected[2] != 2 {
+			t.Errorf("Expected directed betweenness 1 and undirected 2 for vertex 2, got %f and %f", directed[2], undirected[2])
+		}
+	})
+	t.Run("Small integer costs don't overflow", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		builder.AddEdge(1, 2, 200, "edge1-2")
+		builder.AddEdge(2, 3, 100, "edge2-3")
+		builder.AddEdge(1, 3, 250, "edge1-3")
+
+		graph := builder.BuildDirected()
+		betweenness := graph.BetweennessCentrality(true, true)
+
+		// The detour via 2 costs 300, which must not wrap around to look shorter than 250
+		if betweenness[2] != 0 {
+			t.Errorf("Expected betweenness 0 for vertex 2, got %f", betweenness[2])
+		}
+	})
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	t.Run("Directed chain", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		directed := graph.ClosenessCentrality(false, true)
+		undirected := graph.ClosenessCentrality(false, false)
+
+		// Vertex 1 reaches 2 and 3 at distances 1 and 2 in both modes
+		if math.Abs(directed[1]-2.0/3.0) > 1e-9 || math.Abs(undirected[1]-2.0/3.0) > 1e-9 {
+			t.Errorf("Expected closeness 2/3 for vertex 1, got %f and %f", directed[1], undirected[1])
+		}
+
+		// Vertex 2 reaches only 3 in the directed mode, so it's scaled by 1/2
+		if math.Abs(directed[2]-0.5) > 1e-9 || math.Abs(undirected[2]-1.0) > 1e-9 {
+			t.Errorf("Expected closeness 0.5 directed and 1 undirected for vertex 2, got %f and %f", directed[2], undirected[2])
+		}
+
+		// Vertex 3 is a sink, so it reaches nothing in the directed mode
+		if directed[3] != 0 || math.Abs(undirected[3]-2.0/3.0) > 1e-9 {
+			t.Errorf("Expected closeness 0 directed and 2/3 undirected for vertex 3, got %f and %f", directed[3], undirected[3])
+		}
+	})
+
+	t.Run("Weighted mode follows costs", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 2.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 2.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		closeness := graph.ClosenessCentrality(true, true)
+
+		if math.Abs(closeness[2]-0.5) > 1e-9 || math.Abs(closeness[1]-1.0/3.0) > 1e-9 {
+			t.Errorf("Expected closeness 0.5 for vertex 2 and 1/3 for vertex 1, got %f and %f", closeness[2], closeness[1])
+		}
+	})
+	t.Run("Hop counts don't overflow small cost types", func(t *testing.T) {
+		small := &Builder[int, uint8, string, string]{}
+		wide := &Builder[int, float64, string, string]{}
+		for i := 0; i < 299; i++ {
+			small.AddEdge(i, i+1, 1, "")
+			wide.AddEdge(i, i+1, 1.0, "")
+		}
+
+		closeness := small.BuildDirected().ClosenessCentrality(false, true)
+		expected := wide.BuildDirected().ClosenessCentrality(false, true)
+
+		if math.Abs(closeness[0]-expected[0]) > 1e-9 {
+			t.Errorf("Expected closeness %f for vertex 0, got %f", expected[0], closeness[0])
+		}
+	})
+}
+
+func TestEccentricity(t *testing.T) {
+	t.Run("Directed chain", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		directed := graph.Eccentricity(false, true)
+		undirected := graph.Eccentricity(false, false)
+
+		expectedDirected := map[int]float64{1: 3, 2: 2, 3: 1, 4: 0}
+		expectedUndirected := map[int]float64{1: 3, 2: 2, 3: 2, 4: 3}
+		for id := 1; id <= 4; id++ {
+			if directed[id] != expectedDirected[id] {
+				t.Errorf("Expected directed eccentricity %f for vertex %d, got %f", expectedDirected[id], id, directed[id])
+			}
+			if undirected[id] != expectedUndirected[id] {
+				t.Errorf("Expected undirected eccentricity %f for vertex %d, got %f", expectedUndirected[id], id, undirected[id])
+			}
+		}
+	})
+
+	t.Run("Weighted mode uses the cheapest direction", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(2, 3, 2.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		directed := graph.Eccentricity(true, true)
+		undirected := graph.Eccentricity(true, false)
+
+		if directed[1] != 7 || undirected[1] != 3 {
+			t.Errorf("Expected eccentricity 7 directed and 3 undirected for vertex 1, got %f and %f", directed[1], undirected[1])
+		}
+	})
+
+	t.Run("Small integer costs saturate", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		for i := 0; i < 299; i++ {
+			builder.AddEdge(i, i+1, 200, "")
+		}
+
+		graph := builder.BuildDirected()
+		weighted := graph.Eccentricity(true, true)
+		unweighted := graph.Eccentricity(false, true)
+
+		if weighted[0] != math.MaxUint8 || unweighted[0] != math.MaxUint8 {
+			t.Errorf("Expected eccentricity %d for vertex 0, got %d weighted and %d unweighted",
+				math.MaxUint8, weighted[0], unweighted[0])
+		}
+		if weighted[298] != 200 || unweighted[298] != 1 {
+			t.Errorf("Expected eccentricity 200 weighted and 1 unweighted for vertex 298, got %d and %d",
+				weighted[298], unweighted[298])
+		}
+	})
+}
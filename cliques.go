@@ -0,0 +1,104 @@
+package graph
+
+import "sort"
+
+// MaximalCliques finds all maximal cliques of the undirected interpretation of the
+// graph, i.e. the groups of vertices that are all connected to each other and can't
+// be extended by another vertex, e.g. fully-connected friend groups in a social graph.
+// It implements the Bron-Kerbosch algorithm with pivoting, which skips the neighbors
+// of the pivot vertex since every clique containing them is found through the pivot
+// or another non-neighbor. Self-loops and parallel edges are ignored.
+// Isolated vertices form singleton cliques. Returns nil for an empty graph.
+// The vertices of each clique are listed in the order they were added to the graph.
+// Time complexity: O(3^(V/3)) where V is the number of vertices (worst case, which is
+// the maximum number of maximal cliques).
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges,
+// excluding the result.
+func (g *Graph[I, C, V, E]) MaximalCliques() [][]I {
+	if len(g.vertices) == 0 {
+		return nil
+	}
+	adjacency := g.undirectedAdjacency()
+	isNeighbor := make([]map[int]bool, len(g.vertices))
+	for i := range adjacency {
+		isNeighbor[i] = make(map[int]bool, len(adjacency[i]))
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				isNeighbor[i][neighborIdx] = true
+			}
+		}
+	}
+
+	var cliques [][]I
+	var clique []int
+	var extend func(candidates []int, excluded []int)
+	extend = func(candidates []int, excluded []int) {
+		if len(candidates) == 0 {
+			if len(excluded) == 0 {
+				// Keep the vertices of the clique in the insertion order
+				indexes := append([]int(nil), clique...)
+				sort.Ints(indexes)
+				found := make([]I, len(indexes))
+				for i, idx := range indexes {
+					found[i] = g.vertices[idx].id
+				}
+				cliques = append(cliques, found)
+			}
+			return
+		}
+
+		// Choose the pivot with the most neighbors among the candidates
+		pivot, pivotNeighbors := -1, -1
+		for _, set := range [2][]int{candidates, excluded} {
+			for _, idx := range set {
+				count := 0
+				for _, candidateIdx := range candidates {
+					if isNeighbor[idx][candidateIdx] {
+						count++
+					}
+				}
+				if count > pivotNeighbors {
+					pivot, pivotNeighbors = idx, count
+				}
+			}
+		}
+
+		// Iterate over a copy, since the candidates shrink as the vertices are processed
+		for _, idx := range append([]int(nil), candidates...) {
+			if isNeighbor[pivot][idx] {
+				continue
+			}
+			clique = append(clique, idx)
+			extend(intersectNeighbors(candidates, isNeighbor[idx]), intersectNeighbors(excluded, isNeighbor[idx]))
+			clique = clique[:len(clique)-1]
+
+			// Move the vertex from the candidates to the excluded ones. Both slices
+			// are owned by this call, so they can be modified in place.
+			for i, candidateIdx := range candidates {
+				if candidateIdx == idx {
+					candidates = append(candidates[:i], candidates[i+1:]...)
+					break
+				}
+			}
+			excluded = append(excluded, idx)
+		}
+	}
+
+	candidates := make([]int, len(g.vertices))
+	for i := range candidates {
+		candidates[i] = i
+	}
+	extend(candidates, nil)
+	return cliques
+}
+
+// intersectNeighbors returns the vertices of the set that are neighbors of a vertex.
+func intersectNeighbors(set []int, isNeighbor map[int]bool) []int {
+	var result []int
+	for _, idx := range set {
+		if isNeighbor[idx] {
+			result = append(result, idx)
+		}
+	}
+	return result
+}
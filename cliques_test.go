@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMaximalCliques(t *testing.T) {
+	t.Run("Complete graph on four vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i <= 4; i++ {
+			for j := i + 1; j <= 4; j++ {
+				builder.AddBiEdge(i, j, 1.0, "edge")
+			}
+		}
+
+		graph := builder.BuildDirected()
+		cliques := graph.MaximalCliques()
+
+		expected := []int{1, 2, 3, 4}
+		if len(cliques) != 1 || !slicesEqual(cliques[0], expected) {
+			t.Errorf("Expected [%v], got %v", expected, cliques)
+		}
+	})
+
+	t.Run("Two disjoint triangles", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(5, 6, 1.0, "edge5-6")
+		builder.AddEdge(6, 4, 1.0, "edge6-4")
+
+		graph := builder.BuildDirected()
+		cliques := graph.MaximalCliques()
+
+		if len(cliques) != 2 {
+			t.Fatalf("Expected 2 cliques, got %v", cliques)
+		}
+		if cliques[0][0] > cliques[1][0] {
+			cliques[0], cliques[1] = cliques[1], cliques[0]
+		}
+		if !slicesEqual(cliques[0], []int{1, 2, 3}) || !slicesEqual(cliques[1], []int{4, 5, 6}) {
+			t.Errorf("Expected [[1 2 3] [4 5 6]], got %v", cliques)
+		}
+	})
+
+	t.Run("Overlapping cliques and isolated vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Triangles 1-2-3 and 2-3-4 share the edge 2-3, and 4-5 is a plain edge
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(6, 6, 1.0, "loop")
+		builder.AddVertex(7, "isolated")
+
+		graph := builder.BuildDirected()
+		cliques := graph.MaximalCliques()
+
+		found := make(map[string]bool)
+		for _, clique := range cliques {
+			found[fmt.Sprint(clique)] = true
+		}
+		expected := []string{"[1 2 3]", "[2 3 4]", "[4 5]", "[6]", "[7]"}
+		if len(cliques) != len(expected) {
+			t.Errorf("Expected %d cliques, got %v", len(expected), cliques)
+		}
+		for _, clique := range expected {
+			if !found[clique] {
+				t.Errorf("Expected clique %s, got %v", clique, cliques)
+			}
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if cliques := graph.MaximalCliques(); len(cliques) != 0 {
+			t.Errorf("Expected no cliques, got %v", cliques)
+		}
+	})
+}
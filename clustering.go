@@ -0,0 +1,102 @@
+package graph
+
+// vertexTriangles counts the triangles every vertex belongs to in the undirected
+// interpretation of the graph, intersecting the neighbor set of each vertex with
+// the neighbor sets of its neighbors. Self-loops and parallel edges are ignored.
+// Returns the triangle counts and the degrees, both indexed by vertex index.
+// Time complexity: O(V + sum of D^2) where V is the number of vertices and D are their degrees.
+func (g *Graph[I, C, V, E]) vertexTriangles() ([]int, []int) {
+	adjacency := g.undirectedAdjacency()
+	for i := range adjacency {
+		neighbors := adjacency[i][:0]
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				neighbors = append(neighbors, neighborIdx)
+			}
+		}
+		adjacency[i] = neighbors
+	}
+
+	triangles := make([]int, len(g.vertices))
+	degrees := make([]int, len(g.vertices))
+	// The neighbors of the current vertex are stamped with its index
+	stamp := make([]int, len(g.vertices))
+	for i := range stamp {
+		stamp[i] = -1
+	}
+	for i, neighbors := range adjacency {
+		degrees[i] = len(neighbors)
+		for _, neighborIdx := range neighbors {
+			stamp[neighborIdx] = i
+		}
+		// Every edge between two neighbors is seen from both of its ends
+		links := 0
+		for _, neighborIdx := range neighbors {
+			for _, otherIdx := range adjacency[neighborIdx] {
+				if stamp[otherIdx] == i {
+					links++
+				}
+			}
+		}
+		triangles[i] = links / 2
+	}
+
+	return triangles, degrees
+}
+
+// TriangleCount returns the number of triangles in the undirected interpretation
+// of the graph, i.e. the number of vertex triples that are all connected to each
+// other regardless of the edge directions. Self-loops and parallel edges are ignored.
+// Time complexity: O(V + sum of D^2) where V is the number of vertices and D are their degrees.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) TriangleCount() int {
+	triangles, _ := g.vertexTriangles()
+	total := 0
+	for _, count := range triangles {
+		total += count
+	}
+	// Every triangle is counted at each of its three vertices
+	return total / 3
+}
+
+// ClusteringCoefficient computes the local clustering coefficient of every vertex,
+// i.e. the fraction of the pairs of its neighbors that are connected to each other,
+// which quantifies how tightly knit the neighborhood is. The graph is treated as
+// undirected, self-loops and parallel edges are ignored.
+// Vertices with less than two neighbors get 0.
+// Time complexity: O(V + sum of D^2) where V is the number of vertices and D are their degrees.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) ClusteringCoefficient() map[I]float64 {
+	triangles, degrees := g.vertexTriangles()
+	coefficients := make(map[I]float64, len(g.vertices))
+	for i := range g.vertices {
+		coefficients[g.vertices[i].id] = localClustering(triangles[i], degrees[i])
+	}
+	return coefficients
+}
+
+// AverageClustering returns the mean of the local clustering coefficients over all
+// the vertices (see ClusteringCoefficient), which is the global clustering measure
+// of Watts and Strogatz. Returns 0 for an empty graph.
+// Time complexity: O(V + sum of D^2) where V is the number of vertices and D are their degrees.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) AverageClustering() float64 {
+	if len(g.vertices) == 0 {
+		return 0
+	}
+	triangles, degrees := g.vertexTriangles()
+	sum := 0.0
+	for i := range g.vertices {
+		sum += localClustering(triangles[i], degrees[i])
+	}
+	return sum / float64(len(g.vertices))
+}
+
+// localClustering computes the clustering coefficient of a vertex with the given
+// number of triangles and neighbors.
+func localClustering(triangles int, degree int) float64 {
+	if degree < 2 {
+		return 0
+	}
+	return 2 * float64(triangles) / float64(degree*(degree-1))
+}
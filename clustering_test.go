@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTriangleCount(t *testing.T) {
+	t.Run("Complete triangle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		if count := graph.TriangleCount(); count != 1 {
+			t.Errorf("Expected 1 triangle, got %d", count)
+		}
+	})
+
+	t.Run("Complete graph on four vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i <= 4; i++ {
+			for j := i + 1; j <= 4; j++ {
+				builder.AddEdge(i, j, 1.0, "edge")
+			}
+		}
+
+		graph := builder.BuildDirected()
+		if count := graph.TriangleCount(); count != 4 {
+			t.Errorf("Expected 4 triangles, got %d", count)
+		}
+	})
+
+	t.Run("Self-loops and parallel edges are ignored", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 2, 2.0, "parallel")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(3, 3, 1.0, "loop")
+
+		graph := builder.BuildDirected()
+		if count := graph.TriangleCount(); count != 1 {
+			t.Errorf("Expected 1 triangle, got %d", count)
+		}
+	})
+
+	t.Run("Path has no triangles", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		if count := graph.TriangleCount(); count != 0 {
+			t.Errorf("Expected 0 triangles, got %d", count)
+		}
+	})
+}
+
+func TestClusteringCoefficient(t *testing.T) {
+	t.Run("Complete triangle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		coefficients := graph.ClusteringCoefficient()
+		for id := 1; id <= 3; id++ {
+			if coefficients[id] != 1.0 {
+				t.Errorf("Expected coefficient 1 for vertex %d, got %v", id, coefficients[id])
+			}
+		}
+		if average := graph.AverageClustering(); average != 1.0 {
+			t.Errorf("Expected average 1, got %v", average)
+		}
+	})
+
+	t.Run("Star", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for leaf := 1; leaf <= 4; leaf++ {
+			builder.AddBiEdge(0, leaf, 1.0, "spoke")
+		}
+
+		graph := builder.BuildDirected()
+		coefficients := graph.ClusteringCoefficient()
+		if len(coefficients) != 5 {
+			t.Errorf("Expected 5 coefficients, got %d", len(coefficients))
+		}
+		for id, coefficient := range coefficients {
+			if coefficient != 0 {
+				t.Errorf("Expected coefficient 0 for vertex %d, got %v", id, coefficient)
+			}
+		}
+		if average := graph.AverageClustering(); average != 0 {
+			t.Errorf("Expected average 0, got %v", average)
+		}
+	})
+
+	t.Run("Triangle with a pendant vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		coefficients := graph.ClusteringCoefficient()
+		// Vertex 3 has 3 neighbors, and only 1 of their 3 pairs is connected
+		expected := map[int]float64{1: 1, 2: 1, 3: 1.0 / 3, 4: 0}
+		for id, value := range expected {
+			if math.Abs(coefficients[id]-value) > 1e-9 {
+				t.Errorf("Expected coefficient %v for vertex %d, got %v", value, id, coefficients[id])
+			}
+		}
+		if average := graph.AverageClustering(); math.Abs(average-(7.0/3)/4) > 1e-9 {
+			t.Errorf("Expected average %v, got %v", (7.0/3)/4, average)
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if coefficients := graph.ClusteringCoefficient(); len(coefficients) != 0 {
+			t.Errorf("Expected no coefficients, got %v", coefficients)
+		}
+		if average := graph.AverageClustering(); average != 0 {
+			t.Errorf("Expected average 0, got %v", average)
+		}
+	})
+}
@@ -12,6 +12,8 @@ type connectedComponentsVertexData[I Id] struct {
 type ConnectedComponents[I Id, C Cost, V any, E any] struct {
 	graph      *Graph[I, C, V, E]
 	components [][]I
+	// The component ID of each vertex, indexed by the vertex's GetCustomDataIndex()
+	labels []int
 }
 
 // FindConnectedComponents finds all connected components in the graph.
@@ -49,6 +51,10 @@ func FindConnectedComponents[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E
 	}
 
 	cc.components = components
+	cc.labels = make([]int, len(vertexData))
+	for i := range vertexData {
+		cc.labels[i] = vertexData[i].componentId
+	}
 	return cc
 }
 
@@ -60,6 +66,23 @@ func (cc *ConnectedComponents[I, C, V, E]) GetComponents() [][]I {
 	return cc.components
 }
 
+// Labels returns the component membership as a flat array: position i holds the
+// component ID of the vertex at array index i, where the component ID is the index
+// of the component in GetComponents. The second slice holds the vertex IDs in the
+// same order, which allows joining the membership with other per-vertex arrays
+// without map lookups.
+// Time complexity: O(V) where V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+func (cc *ConnectedComponents[I, C, V, E]) Labels() ([]int, []I) {
+	labels := make([]int, len(cc.labels))
+	copy(labels, cc.labels)
+	ids := make([]I, len(cc.graph.vertices))
+	for i := range cc.graph.vertices {
+		ids[i] = cc.graph.vertices[i].id
+	}
+	return labels, ids
+}
+
 // findConnectedComponentsWithDfs performs depth-first search starting from the given vertex.
 // It marks all reachable vertices as visited and assigns them the same component ID.
 // For directed graphs, this considers both incoming and outgoing edges to find
@@ -455,6 +455,59 @@ func TestConnectedComponentsWithDifferentTypes(t *testing.T) {
 	})
 }
 
+func TestConnectedComponentsLabels(t *testing.T) {
+	t.Run("Labels match the components", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddVertex(6, "isolated")
+
+		graph := builder.BuildDirected()
+		cc := FindConnectedComponents(graph)
+		labels, ids := cc.Labels()
+
+		if len(labels) != 6 || len(ids) != 6 {
+			t.Fatalf("Expected 6 labels and IDs, got %d and %d", len(labels), len(ids))
+		}
+		labelOf := make(map[int]int)
+		for i, id := range ids {
+			vertex, err := graph.GetVertexById(id)
+			if err != nil || vertex.GetCustomDataIndex() != i {
+				t.Errorf("Expected vertex %d at array index %d", id, i)
+			}
+			labelOf[id] = labels[i]
+		}
+
+		if labelOf[1] != labelOf[2] || labelOf[2] != labelOf[3] {
+			t.Errorf("Expected vertices 1, 2 and 3 to share a label, got %v", labelOf)
+		}
+		if labelOf[4] != labelOf[5] {
+			t.Errorf("Expected vertices 4 and 5 to share a label, got %v", labelOf)
+		}
+		if labelOf[1] == labelOf[4] || labelOf[1] == labelOf[6] || labelOf[4] == labelOf[6] {
+			t.Errorf("Expected different labels for different components, got %v", labelOf)
+		}
+
+		components := cc.GetComponents()
+		for id, label := range labelOf {
+			if component := cc.GetComponentForVertex(id); !slicesEqual(components[label], component) {
+				t.Errorf("Expected label %d of vertex %d to index its component %v", label, id, component)
+			}
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+		labels, ids := FindConnectedComponents(graph).Labels()
+
+		if len(labels) != 0 || len(ids) != 0 {
+			t.Errorf("Expected no labels, got %v and %v", labels, ids)
+		}
+	})
+}
+
 func TestConnectedComponentsPerformance(t *testing.T) {
 	t.Run("Large connected graph", func(t *testing.T) {
 		builder := &Builder[int, float64, string, string]{}
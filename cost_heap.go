@@ -0,0 +1,37 @@
+package graph
+
+// costHeapItem is an entry of the costHeap: a vertex index with its priority.
+type costHeapItem[C Cost] struct {
+	cost      C
+	vertexIdx int
+}
+
+// costHeap implements heap.Interface for a min-priority queue of vertex indexes
+// keyed by cost. Unlike dijkstraHeap it stores the priority in the entry itself,
+// so it can be used by algorithms that don't keep per-vertex state in an algorithm object.
+type costHeap[C Cost] []costHeapItem[C]
+
+func (h costHeap[C]) Len() int { return len(h) }
+
+func (h costHeap[C]) Less(i, j int) bool { return h[i].cost < h[j].cost }
+
+func (h costHeap[C]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *costHeap[C]) Push(x any) {
+	*h = append(*h, x.(costHeapItem[C]))
+}
+
+func (h *costHeap[C]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// maxCostHeap is the max-priority counterpart of costHeap.
+type maxCostHeap[C Cost] struct {
+	costHeap[C]
+}
+
+func (h maxCostHeap[C]) Less(i, j int) bool { return h.costHeap[i].cost > h.costHeap[j].cost }
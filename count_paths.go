@@ -0,0 +1,80 @@
+package graph
+
+import "errors"
+
+// ErrCycleDetected is returned by algorithms that require a directed acyclic graph
+// when a directed cycle is found.
+var ErrCycleDetected = errors.New("graph contains a cycle")
+
+// CountPaths returns the number of distinct directed paths from start to end.
+// The counts are computed with a memoized iterative DFS (a dynamic programming
+// over the topological order), so each vertex is processed only once.
+// Returns ErrCycleDetected if a cycle is reachable from the start vertex, since
+// the number of paths may be infinite then. Returns an error if either vertex doesn't exist.
+// Counts exceeding the uint64 range wrap around.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) CountPaths(start I, end I) (uint64, error) {
+	startVertex, err := g.GetVertexById(start)
+	if err != nil {
+		return 0, err
+	}
+	endVertex, err := g.GetVertexById(end)
+	if err != nil {
+		return 0, err
+	}
+
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make([]uint8, len(g.vertices))
+	counts := make([]uint64, len(g.vertices))
+
+	// The end vertex terminates every path, so its successors are never expanded
+	endIdx := endVertex.GetCustomDataIndex()
+	state[endIdx] = done
+	counts[endIdx] = 1
+
+	type stackItem struct {
+		vertexIdx int
+		edgeIdx   int // Index of the next outgoing edge to process
+	}
+	startIdx := startVertex.GetCustomDataIndex()
+	var stack []stackItem
+	if state[startIdx] == unvisited {
+		state[startIdx] = inProgress
+		stack = append(stack, stackItem{vertexIdx: startIdx})
+	}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		edges := g.vertices[top.vertexIdx].edges
+
+		// All successors are counted, the vertex count is final
+		if top.edgeIdx == len(edges) {
+			state[top.vertexIdx] = done
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				parent := &stack[len(stack)-1]
+				counts[parent.vertexIdx] += counts[top.vertexIdx]
+			}
+			continue
+		}
+
+		neighborIdx := edges[top.edgeIdx].targetVertex.GetCustomDataIndex()
+		top.edgeIdx++
+		switch state[neighborIdx] {
+		case done:
+			counts[top.vertexIdx] += counts[neighborIdx]
+		case inProgress:
+			return 0, ErrCycleDetected
+		default:
+			state[neighborIdx] = inProgress
+			stack = append(stack, stackItem{vertexIdx: neighborIdx})
+		}
+	}
+
+	return counts[startIdx], nil
+}
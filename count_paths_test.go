@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCountPaths(t *testing.T) {
+	t.Run("Diamond has two paths", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		count, err := graph.CountPaths(1, 4)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if count != 2 {
+			t.Errorf("Expected 2 paths, got %d", count)
+		}
+	})
+
+	t.Run("Chained diamonds multiply", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(4, 6, 1.0, "edge4-6")
+		builder.AddEdge(4, 7, 1.0, "edge4-7")
+		builder.AddEdge(5, 8, 1.0, "edge5-8")
+		builder.AddEdge(6, 8, 1.0, "edge6-8")
+		builder.AddEdge(7, 8, 1.0, "edge7-8")
+		builder.AddEdge(1, 8, 1.0, "edge1-8")
+
+		graph := builder.BuildDirected()
+		count, err := graph.CountPaths(1, 8)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if count != 7 {
+			t.Errorf("Expected 7 paths, got %d", count)
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		count, err := graph.CountPaths(1, 1)
+
+		if err != nil || count != 1 {
+			t.Errorf("Expected 1 path and no error, got %d, %v", count, err)
+		}
+	})
+
+	t.Run("Unreachable end", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+
+		graph := builder.BuildDirected()
+		count, err := graph.CountPaths(1, 3)
+
+		if err != nil || count != 0 {
+			t.Errorf("Expected 0 paths and no error, got %d, %v", count, err)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		_, err := graph.CountPaths(1, 4)
+
+		if !errors.Is(err, ErrCycleDetected) {
+			t.Errorf("Expected ErrCycleDetected, got %v", err)
+		}
+	})
+
+	t.Run("Non-existent vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+
+		if _, err := graph.CountPaths(999, 2); err == nil {
+			t.Error("Expected error for non-existent start vertex")
+		}
+
+		if _, err := graph.CountPaths(1, 999); err == nil {
+			t.Error("Expected error for non-existent end vertex")
+		}
+	})
+}
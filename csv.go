@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// WriteCSV exports the graph as two CSV streams, e.g. for spreadsheets and data pipelines.
+// The edges stream has one row per directed edge: origin,target,cost followed by the
+// fields of the custom edge data. The vertices stream has one row per vertex in the
+// order of the vertices: id followed by the fields of the custom vertex data, so the
+// isolated vertices aren't lost. There are no header rows.
+// Since the custom data types are generic, they are converted to fields by the encoder
+// callbacks, which must always return the same number of fields. A nil encoder omits
+// the data fields. The values are quoted as needed, so string IDs may contain commas,
+// quotes and line breaks.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(1), not counting the encoded fields.
+func (g *Graph[I, C, V, E]) WriteCSV(
+	edges io.Writer,
+	vertices io.Writer,
+	encodeVertex func(data V) []string,
+	encodeEdge func(data E) []string,
+) error {
+	writer := csv.NewWriter(edges)
+	for i := range g.vertices {
+		origin := fmt.Sprint(g.vertices[i].id)
+		for _, edge := range g.vertices[i].edges {
+			record := []string{origin, fmt.Sprint(edge.targetVertex.id), fmt.Sprint(edge.cost)}
+			if encodeEdge != nil {
+				record = append(record, encodeEdge(g.customEdgeData[edge.customDataIndex])...)
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	writer = csv.NewWriter(vertices)
+	for i := range g.vertices {
+		record := []string{fmt.Sprint(g.vertices[i].id)}
+		if encodeVertex != nil {
+			record = append(record, encodeVertex(g.customVertexData[g.vertices[i].customDataIndex])...)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadCSV builds a directed graph from the edges and vertices CSV streams in the format
+// produced by WriteCSV. The decoder callbacks get the data fields that follow the fixed
+// ones and convert them to the custom data types. A nil decoder leaves the zero value.
+// The vertices that only appear in the edges stream get the zero value of the custom
+// vertex data type. The vertex order follows the first appearance of the vertices in the
+// edges stream, then in the vertices stream, like with the Builder.
+// Returns an error if a stream is malformed or a value can't be parsed or decoded.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func ReadCSV[I Id, C Cost, V any, E any](
+	edges io.Reader,
+	vertices io.Reader,
+	decodeVertex func(fields []string) (V, error),
+	decodeEdge func(fields []string) (E, error),
+) (*Graph[I, C, V, E], error) {
+	builder := &Builder[I, C, V, E]{}
+
+	reader := csv.NewReader(edges)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) < 3 {
+			return nil, fmt.Errorf("edges line %d: expected at least 3 fields, got %d", line, len(record))
+		}
+		dto := &BasicEdgeDto[I, C, E]{}
+		if err := parseCSVValue(record[0], &dto.Origin); err != nil {
+			return nil, fmt.Errorf("edges line %d: invalid origin: %w", line, err)
+		}
+		if err := parseCSVValue(record[1], &dto.Target); err != nil {
+			return nil, fmt.Errorf("edges line %d: invalid target: %w", line, err)
+		}
+		if err := parseCSVValue(record[2], &dto.Cost); err != nil {
+			return nil, fmt.Errorf("edges line %d: invalid cost: %w", line, err)
+		}
+		if decodeEdge != nil {
+			if dto.Data, err = decodeEdge(record[3:]); err != nil {
+				return nil, fmt.Errorf("edges line %d: %w", line, err)
+			}
+		}
+		builder.AddEdgeDto(dto)
+	}
+
+	reader = csv.NewReader(vertices)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		dto := &BasicVertexDto[I, V]{}
+		if err := parseCSVValue(record[0], &dto.Id); err != nil {
+			return nil, fmt.Errorf("vertices line %d: invalid id: %w", line, err)
+		}
+		if decodeVertex != nil {
+			if dto.Data, err = decodeVertex(record[1:]); err != nil {
+				return nil, fmt.Errorf("vertices line %d: %w", line, err)
+			}
+		}
+		builder.AddVertexDto(dto)
+	}
+
+	return builder.BuildDirected(), nil
+}
+
+// parseCSVValue parses a CSV field into an ID or a cost. Strings are taken verbatim,
+// since they may contain spaces, and the numbers must take the whole field.
+func parseCSVValue[T Id | Cost](field string, value *T) error {
+	if p, ok := any(value).(*string); ok {
+		*p = field
+		return nil
+	}
+	var rest string
+	if n, _ := fmt.Sscan(field+" -", value, &rest); n != 2 || rest != "-" {
+		return errors.New("not a number: " + field)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGraphCSV(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		builder := &Builder[string, float64, int, string]{}
+		builder.AddEdge("a", "b, c", 1.5, "plain")
+		builder.AddEdge("b, c", `say "hi"`, 0.1, "with, comma")
+		builder.AddEdge(`say "hi"`, "a", 3, "multi\nline")
+		builder.AddVertex("a", 1)
+		builder.AddVertex("b, c", 2)
+		builder.AddVertex("lonely", 3)
+		graph := builder.BuildDirected()
+
+		var edges, vertices bytes.Buffer
+		err := graph.WriteCSV(&edges, &vertices,
+			func(data int) []string { return []string{strconv.Itoa(data)} },
+			func(data string) []string { return []string{data} },
+		)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		restored, err := ReadCSV[string, float64, int, string](&edges, &vertices,
+			func(fields []string) (int, error) { return strconv.Atoi(fields[0]) },
+			func(fields []string) (string, error) { return fields[0], nil },
+		)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if restored.GetVertexCount() != graph.GetVertexCount() {
+			t.Errorf("Expected %d vertices, got %d", graph.GetVertexCount(), restored.GetVertexCount())
+		}
+		graph.VisitVertices(func(vertex *Vertex[string, float64]) {
+			restoredVertex, err := restored.GetVertexById(vertex.GetId())
+			if err != nil {
+				t.Errorf("Expected vertex %q, got none", vertex.GetId())
+				return
+			}
+			data, _ := graph.GetVertexData(vertex)
+			restoredData, _ := restored.GetVertexData(restoredVertex)
+			if *restoredData != *data {
+				t.Errorf("Expected vertex %q data %d, got %d", vertex.GetId(), *data, *restoredData)
+			}
+			edges := vertex.GetEdges()
+			restoredEdges := restoredVertex.GetEdges()
+			if len(restoredEdges) != len(edges) {
+				t.Errorf("Expected %d edges of %q, got %d", len(edges), vertex.GetId(), len(restoredEdges))
+				return
+			}
+			for i := range edges {
+				edgeData, _ := graph.GetEdgeData(&edges[i])
+				restoredEdgeData, _ := restored.GetEdgeData(&restoredEdges[i])
+				if restoredEdges[i].GetTargetVertex().GetId() != edges[i].GetTargetVertex().GetId() ||
+					restoredEdges[i].GetCost() != edges[i].GetCost() ||
+					*restoredEdgeData != *edgeData {
+					t.Errorf("Expected edge %q->%q (%v, %q), got %q->%q (%v, %q)",
+						vertex.GetId(), edges[i].GetTargetVertex().GetId(), edges[i].GetCost(), *edgeData,
+						vertex.GetId(), restoredEdges[i].GetTargetVertex().GetId(), restoredEdges[i].GetCost(), *restoredEdgeData)
+				}
+			}
+		})
+	})
+
+	t.Run("Without data fields", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 5, "edge1-2")
+		builder.AddEdge(2, 3, 7, "edge2-3")
+		graph := builder.BuildDirected()
+
+		var edges, vertices bytes.Buffer
+		if err := graph.WriteCSV(&edges, &vertices, nil, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if edges.String() != "1,2,5\n2,3,7\n" {
+			t.Errorf("Expected edges %q, got %q", "1,2,5\n2,3,7\n", edges.String())
+		}
+		if vertices.String() != "1\n2\n3\n" {
+			t.Errorf("Expected vertices %q, got %q", "1\n2\n3\n", vertices.String())
+		}
+	})
+
+	t.Run("Invalid values", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			edges    string
+			vertices string
+		}{
+			{"Invalid cost", "1,2,abc\n", ""},
+			{"Trailing garbage", "1,2,3x\n", ""},
+			{"Invalid id", "1,2,3\n", "x\n"},
+			{"Missing fields", "1,2\n", ""},
+			{"Malformed quotes", "1,\"2,3\n", ""},
+		}
+		for _, c := range cases {
+			_, err := ReadCSV[int, int, string, string](strings.NewReader(c.edges), strings.NewReader(c.vertices), nil, nil)
+			if err == nil {
+				t.Errorf("%s: expected an error, got nil", c.name)
+			}
+		}
+	})
+
+	t.Run("Decoder error", func(t *testing.T) {
+		decodeErr := errors.New("bad data")
+		_, err := ReadCSV[int, int, string, string](strings.NewReader("1,2,3,x\n"), strings.NewReader(""), nil,
+			func(fields []string) (string, error) { return "", decodeErr },
+		)
+		if !errors.Is(err, decodeErr) {
+			t.Errorf("Expected %v, got %v", decodeErr, err)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"math"
+	"sort"
+)
+
+// CurrentFlowBetweenness computes the current-flow (electrical) betweenness of every edge.
+// The graph is modeled as a resistor network where each edge cost is the resistance.
+// A unit current is injected at the source and extracted at the sink for every pair of
+// vertices, and the absolute current flowing through each edge is averaged over all pairs.
+// Unlike the shortest-path betweenness, this also accounts for edges lying on
+// non-geodesic routes, which makes it suitable for diffusion-like processes.
+// The graph is treated as undirected, all the edges connecting the same pair of
+// vertices form a single resistor with the minimum cost among them as resistance,
+// and the resulting keys are normalized so that Origin < Target.
+// Edge costs must be positive, self-loops and edges with non-positive costs are ignored.
+// Time complexity: O(V^3 + E * V log V) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V^2) where V is the number of vertices.
+func CurrentFlowBetweenness[I Id, C Cost, V any, E any](g *Graph[I, C, V, E]) map[EdgeKey[I]]float64 {
+	vertexCount := len(g.vertices)
+	result := make(map[EdgeKey[I]]float64)
+	if vertexCount < 2 {
+		return result
+	}
+
+	// Collect the resistors, one per unordered pair of adjacent vertices
+	type resistor struct {
+		aIdx, bIdx  int
+		conductance float64
+	}
+	resistorIdx := make(map[biEdgeKey[int]]int)
+	var resistors []resistor
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			resistance := float64(edge.cost)
+			if targetIdx == i || resistance <= 0 {
+				continue
+			}
+			key := biEdgeKey[int]{origin: i, target: targetIdx}
+			if key.origin > key.target {
+				key.origin, key.target = key.target, key.origin
+			}
+			if k, exists := resistorIdx[key]; exists {
+				resistors[k].conductance = math.Max(resistors[k].conductance, 1/resistance)
+				continue
+			}
+			resistorIdx[key] = len(resistors)
+			resistors = append(resistors, resistor{aIdx: key.origin, bIdx: key.target, conductance: 1 / resistance})
+		}
+	}
+
+	// The Laplacian pseudoinverse is block diagonal over the connected components,
+	// so every component is solved on its own
+	componentOf := make([]int, vertexCount)
+	positionOf := make([]int, vertexCount)
+	var members [][]int
+	adjacency := make([][]int, vertexCount)
+	for k, r := range resistors {
+		adjacency[r.aIdx] = append(adjacency[r.aIdx], k)
+		adjacency[r.bIdx] = append(adjacency[r.bIdx], k)
+	}
+	for i := range componentOf {
+		componentOf[i] = -1
+	}
+	for start := range g.vertices {
+		if componentOf[start] >= 0 {
+			continue
+		}
+		componentId := len(members)
+		component := []int{start}
+		componentOf[start] = componentId
+		for head := 0; head < len(component); head++ {
+			currentIdx := component[head]
+			positionOf[currentIdx] = head
+			for _, k := range adjacency[currentIdx] {
+				neighborIdx := resistors[k].aIdx + resistors[k].bIdx - currentIdx
+				if componentOf[neighborIdx] < 0 {
+					componentOf[neighborIdx] = componentId
+					component = append(component, neighborIdx)
+				}
+			}
+		}
+		members = append(members, component)
+	}
+
+	pseudoInverses := make([][][]float64, len(members))
+	for componentId, component := range members {
+		size := len(component)
+		if size < 2 {
+			continue
+		}
+		// L + J/n is invertible for a connected component, and
+		// pinv(L) = inv(L + J/n) - J/n
+		matrix := make([][]float64, size)
+		for i := range matrix {
+			matrix[i] = make([]float64, size)
+			for j := range matrix[i] {
+				matrix[i][j] = 1 / float64(size)
+			}
+		}
+		for _, idx := range component {
+			for _, k := range adjacency[idx] {
+				r := resistors[k]
+				neighborIdx := r.aIdx + r.bIdx - idx
+				matrix[positionOf[idx]][positionOf[idx]] += r.conductance
+				matrix[positionOf[idx]][positionOf[neighborIdx]] -= r.conductance
+			}
+		}
+		inverse := invertMatrix(matrix)
+		for i := range inverse {
+			for j := range inverse[i] {
+				inverse[i][j] -= 1 / float64(size)
+			}
+		}
+		pseudoInverses[componentId] = inverse
+	}
+
+	// The current through resistor (a, b) for the pair (s, t) is
+	// c * |(P[a][s] - P[b][s]) - (P[a][t] - P[b][t])|, so summing over all pairs
+	// is summing absolute differences of a single vector, which sorting makes linear
+	pairCount := float64(vertexCount) * float64(vertexCount-1) / 2
+	for _, r := range resistors {
+		componentId := componentOf[r.aIdx]
+		inverse := pseudoInverses[componentId]
+		a, b := positionOf[r.aIdx], positionOf[r.bIdx]
+		potentials := make([]float64, len(inverse))
+		for s := range potentials {
+			potentials[s] = inverse[a][s] - inverse[b][s]
+		}
+		sort.Float64s(potentials)
+		total := 0.0
+		for s := range potentials {
+			total += potentials[s] * float64(2*s-len(potentials)+1)
+		}
+		key := EdgeKey[I]{Origin: g.vertices[r.aIdx].id, Target: g.vertices[r.bIdx].id}
+		if key.Origin > key.Target {
+			key.Origin, key.Target = key.Target, key.Origin
+		}
+		result[key] = r.conductance * total / pairCount
+	}
+
+	return result
+}
+
+// invertMatrix inverts a square non-singular matrix using Gauss-Jordan elimination
+// with partial pivoting. The input matrix is destroyed.
+func invertMatrix(matrix [][]float64) [][]float64 {
+	size := len(matrix)
+	inverse := make([][]float64, size)
+	for i := range inverse {
+		inverse[i] = make([]float64, size)
+		inverse[i][i] = 1
+	}
+	for col := 0; col < size; col++ {
+		pivot := col
+		for row := col + 1; row < size; row++ {
+			if math.Abs(matrix[row][col]) > math.Abs(matrix[pivot][col]) {
+				pivot = row
+			}
+		}
+		matrix[col], matrix[pivot] = matrix[pivot], matrix[col]
+		inverse[col], inverse[pivot] = inverse[pivot], inverse[col]
+
+		scale := 1 / matrix[col][col]
+		for j := 0; j < size; j++ {
+			matrix[col][j] *= scale
+			inverse[col][j] *= scale
+		}
+		for row := 0; row < size; row++ {
+			if row == col || matrix[row][col] == 0 {
+				continue
+			}
+			factor := matrix[row][col]
+			for j := 0; j < size; j++ {
+				matrix[row][j] -= factor * matrix[col][j]
+				inverse[row][j] -= factor * inverse[col][j]
+			}
+		}
+	}
+	return inverse
+}
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCurrentFlowBetweenness(t *testing.T) {
+	t.Run("Central edge carries the most current", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Two triangles joined by the central edge 3-4
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(3, 1, 1.0, "edge3-1")
+		builder.AddBiEdge(3, 4, 1.0, "edge3-4")
+		builder.AddBiEdge(4, 5, 1.0, "edge4-5")
+		builder.AddBiEdge(5, 6, 1.0, "edge5-6")
+		builder.AddBiEdge(6, 4, 1.0, "edge6-4")
+
+		graph := builder.BuildDirected()
+		betweenness := CurrentFlowBetweenness(graph)
+
+		if len(betweenness) != 7 {
+			t.Fatalf("Expected 7 undirected edges, got %d", len(betweenness))
+		}
+
+		central := betweenness[EdgeKey[int]{Origin: 3, Target: 4}]
+		for key, value := range betweenness {
+			if key.Origin > key.Target {
+				t.Errorf("Expected normalized key, got %v", key)
+			}
+			if key != (EdgeKey[int]{Origin: 3, Target: 4}) && value >= central {
+				t.Errorf("Expected central edge (%f) to carry more current than %v (%f)", central, key, value)
+			}
+		}
+
+		// All 9 pairs across the bridge push a unit current through it, out of 15 pairs
+		if math.Abs(central-9.0/15.0) > 1e-9 {
+			t.Errorf("Expected central edge betweenness %f, got %f", 9.0/15.0, central)
+		}
+	})
+
+	t.Run("Path graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 2.0, "edge1-2")
+		builder.AddEdge(2, 3, 5.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		betweenness := CurrentFlowBetweenness(graph)
+
+		// On a path every current must flow along it, regardless of resistance
+		expected := 2.0 / 3.0
+		for key, value := range betweenness {
+			if math.Abs(value-expected) > 1e-9 {
+				t.Errorf("Expected betweenness %f for %v, got %f", expected, key, value)
+			}
+		}
+	})
+
+	t.Run("Disconnected graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		betweenness := CurrentFlowBetweenness(graph)
+
+		// Only the pair of its own endpoints sends current through each edge
+		expected := 1.0 / 6.0
+		for key, value := range betweenness {
+			if math.Abs(value-expected) > 1e-9 {
+				t.Errorf("Expected betweenness %f for %v, got %f", expected, key, value)
+			}
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if betweenness := CurrentFlowBetweenness(graph); len(betweenness) != 0 {
+			t.Errorf("Expected empty result, got %v", betweenness)
+		}
+	})
+}
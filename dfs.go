@@ -14,6 +14,16 @@ type dfsVertexData[I Id, C Cost] struct {
 type DFS[I Id, C Cost, V any, E any] struct {
 	graph      *Graph[I, C, V, E]
 	vertexData []dfsVertexData[I, C]
+	// MaxBranch limits the number of outgoing edges expanded from each vertex
+	// during traversals and searches, which enables approximate (beam-search style)
+	// exploration of dense graphs. Only the MaxBranch best edges according to
+	// BranchLess are followed. Zero means no limit.
+	// Cycle detection always considers all edges.
+	MaxBranch int
+	// BranchLess orders the edges when MaxBranch is set. If nil, the cheapest
+	// edges are preferred.
+	BranchLess BranchLessFunc[I, C]
+	branchBuf  []int
 }
 
 // Creates a new DFS instance for the given graph.
@@ -28,6 +38,19 @@ func NewDFS[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *DFS[I, C, V,
 	return algorithm
 }
 
+// Reset rebinds the DFS instance to another graph, so that one instance can be
+// reused across many graphs in batch workloads. The vertex data slice is reallocated
+// only when the new graph has more vertices than the current capacity.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *DFS[I, C, V, E]) Reset(graph *Graph[I, C, V, E]) {
+	d.graph = graph
+	if cap(d.vertexData) >= len(graph.vertices) {
+		d.vertexData = d.vertexData[:len(graph.vertices)]
+	} else {
+		d.vertexData = make([]dfsVertexData[I, C], len(graph.vertices))
+	}
+}
+
 // TraverseFrom performs a depth-first search starting from the given vertex,
 // calling the provided callback function for each vertex and edge visited.
 // The callback receives the current vertex and the edge that led to it (nil for the start vertex).
@@ -48,10 +71,101 @@ func (d *DFS[I, C, V, E]) TraverseFrom(start I, callback func(vertex *Vertex[I,
 		d.vertexData[i].visiting = false
 	}
 
+	// Perform DFS traversal with callback
+	d.dfsTraverseWithCallback(startVertex, nil, func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		callback(vertex, edge)
+		return true
+	})
+}
+
+// TraverseFromUntil performs a depth-first search starting from the given vertex,
+// calling the provided callback function for each vertex and edge visited until
+// the callback returns false, at which point the traversal stops immediately.
+// The callback receives the current vertex and the edge that led to it (nil for the start vertex).
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *DFS[I, C, V, E]) TraverseFromUntil(start I, callback func(vertex *Vertex[I, C], edge *Edge[I, C]) bool) {
+	// Check if start vertex exists
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return // Start vertex not found
+	}
+
+	// Initialize vertex data for all vertices
+	for i := range d.vertexData {
+		d.vertexData[i].visited = false
+		d.vertexData[i].parent = nil
+		d.vertexData[i].visiting = false
+	}
+
 	// Perform DFS traversal with callback
 	d.dfsTraverseWithCallback(startVertex, nil, callback)
 }
 
+// TraverseFromOrdered performs a depth-first search starting from the given vertex,
+// calling preorder when a vertex is visited for the first time and postorder when all
+// its descendants are done. The post-order finish sequence is what e.g. the topological
+// sort and the strongly connected components algorithms are built on.
+// Either callback may be nil. The vertices are explored in the edge order the same way
+// as a recursive DFS would, but with an explicit stack. MaxBranch is respected as by
+// TraverseFrom.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *DFS[I, C, V, E]) TraverseFromOrdered(start I, preorder func(*Vertex[I, C]), postorder func(*Vertex[I, C])) {
+	// Check if start vertex exists
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return // Start vertex not found
+	}
+
+	// Initialize vertex data for all vertices
+	for i := range d.vertexData {
+		d.vertexData[i].visited = false
+		d.vertexData[i].parent = nil
+		d.vertexData[i].visiting = false
+	}
+
+	// Each stack item keeps the edges of its vertex left to explore, since the
+	// branches selected for different vertices are needed at the same time
+	type stackItem struct {
+		vertex   *Vertex[I, C]
+		branches []int
+		next     int // Index of the next branch to explore
+	}
+	visit := func(vertex *Vertex[I, C]) stackItem {
+		d.vertexData[vertex.GetCustomDataIndex()].visited = true
+		if preorder != nil {
+			preorder(vertex)
+		}
+		d.branchBuf = selectBranches(vertex.edges, d.MaxBranch, d.BranchLess, d.branchBuf)
+		return stackItem{vertex: vertex, branches: append([]int(nil), d.branchBuf...)}
+	}
+
+	stack := []stackItem{visit(startVertex)}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(top.branches) {
+			// All the descendants are done
+			if postorder != nil {
+				postorder(top.vertex)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		current := top.vertex
+		neighbor := current.edges[top.branches[top.next]].targetVertex
+		top.next++
+		neighborData := &d.vertexData[neighbor.GetCustomDataIndex()]
+		if !neighborData.visited {
+			neighborData.parent = current
+			stack = append(stack, visit(neighbor))
+		}
+	}
+}
+
 // FindPath finds a path from start to end vertex using DFS.
 // Returns a slice of vertex IDs representing the path, or nil if no path exists.
 // Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
@@ -97,9 +211,7 @@ func (d *DFS[I, C, V, E]) FindPath(start I, end I) []I {
 	}
 
 	// Reverse the path to get start-to-end order
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
-	}
+	reversePath(path)
 
 	return path
 }
@@ -189,7 +301,9 @@ func (d *DFS[I, C, V, E]) dfsTraverse(vertex *Vertex[I, C], result *[]I) {
 		// Add all unvisited neighbors to stack
 		// We reverse the order to maintain the same traversal order as recursive version
 		edges := current.GetEdges()
-		for i := len(edges) - 1; i >= 0; i-- {
+		d.branchBuf = selectBranches(edges, d.MaxBranch, d.BranchLess, d.branchBuf)
+		for k := len(d.branchBuf) - 1; k >= 0; k-- {
+			i := d.branchBuf[k]
 			neighbor := edges[i].GetTargetVertex()
 			neighborIdx := neighbor.GetCustomDataIndex()
 			neighborData := &d.vertexData[neighborIdx]
@@ -204,8 +318,9 @@ func (d *DFS[I, C, V, E]) dfsTraverse(vertex *Vertex[I, C], result *[]I) {
 
 // dfsTraverseWithCallback performs DFS traversal with a callback function.
 // It marks all reachable vertices as visited and calls the callback for each vertex and edge.
+// The traversal stops as soon as the callback returns false.
 // Uses an iterative approach with an explicit stack to avoid recursion.
-func (d *DFS[I, C, V, E]) dfsTraverseWithCallback(startVertex *Vertex[I, C], startEdge *Edge[I, C], callback func(vertex *Vertex[I, C], edge *Edge[I, C])) {
+func (d *DFS[I, C, V, E]) dfsTraverseWithCallback(startVertex *Vertex[I, C], startEdge *Edge[I, C], callback func(vertex *Vertex[I, C], edge *Edge[I, C]) bool) {
 	// Use a stack to store vertices and their incoming edges
 	type stackItem struct {
 		vertex *Vertex[I, C]
@@ -230,14 +345,18 @@ func (d *DFS[I, C, V, E]) dfsTraverseWithCallback(startVertex *Vertex[I, C], sta
 			continue
 		}
 
-		// Mark as visited and call callback
+		// Mark as visited and call callback, stopping if requested
 		currentData.visited = true
-		callback(current, incomingEdge)
+		if !callback(current, incomingEdge) {
+			return
+		}
 
 		// Add all unvisited neighbors to stack
 		// We reverse the order to maintain the same traversal order as recursive version
 		edges := current.GetEdges()
-		for i := len(edges) - 1; i >= 0; i-- {
+		d.branchBuf = selectBranches(edges, d.MaxBranch, d.BranchLess, d.branchBuf)
+		for k := len(d.branchBuf) - 1; k >= 0; k-- {
+			i := d.branchBuf[k]
 			neighbor := edges[i].GetTargetVertex()
 			neighborIdx := neighbor.GetCustomDataIndex()
 			neighborData := &d.vertexData[neighborIdx]
@@ -292,7 +411,9 @@ func (d *DFS[I, C, V, E]) dfsSearch(start *Vertex[I, C], target interface{}) boo
 		// Add all unvisited neighbors to stack
 		// We reverse the order to maintain the same traversal order as recursive version
 		edges := current.GetEdges()
-		for i := len(edges) - 1; i >= 0; i-- {
+		d.branchBuf = selectBranches(edges, d.MaxBranch, d.BranchLess, d.branchBuf)
+		for k := len(d.branchBuf) - 1; k >= 0; k-- {
+			i := d.branchBuf[k]
 			neighbor := edges[i].GetTargetVertex()
 			neighborIdx := neighbor.GetCustomDataIndex()
 			neighborData := &d.vertexData[neighborIdx]
@@ -307,6 +428,14 @@ func (d *DFS[I, C, V, E]) dfsSearch(start *Vertex[I, C], target interface{}) boo
 	return false
 }
 
+// Cycle is a cycle found in the graph together with the edges forming it.
+// Edges[i] leads from Vertices[i] to the next vertex of the cycle, and the last edge
+// leads back to Vertices[0], so a self-loop is a single vertex with a single edge.
+type Cycle[I Id, C Cost] struct {
+	Vertices []I
+	Edges    []*Edge[I, C]
+}
+
 // FindCycles finds all cycles in the graph.
 // Returns a slice of cycles, where each cycle is represented as a slice of vertex IDs.
 // For directed graphs, this detects directed cycles.
@@ -315,6 +444,26 @@ func (d *DFS[I, C, V, E]) dfsSearch(start *Vertex[I, C], target interface{}) boo
 // Space complexity: O(V) where V is the number of vertices.
 // WARNING: This function is not thread-safe and should not be called concurrently.
 func (d *DFS[I, C, V, E]) FindCycles() [][]I {
+	var cycles [][]I
+	for _, cycle := range d.findCycles() {
+		cycles = append(cycles, cycle.Vertices)
+	}
+	return cycles
+}
+
+// FindCyclesWithEdges finds the same cycles as FindCycles, but also returns the edges
+// forming each cycle, so that their custom data can be inspected, e.g. to tell which
+// dependency is circular. If the vertices are connected by parallel edges, the exact
+// edge traversed by the search is returned.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *DFS[I, C, V, E]) FindCyclesWithEdges() []Cycle[I, C] {
+	return d.findCycles()
+}
+
+// findCycles implements FindCycles and FindCyclesWithEdges.
+func (d *DFS[I, C, V, E]) findCycles() []Cycle[I, C] {
 	// Initialize vertex data for all vertices
 	for i := range d.vertexData {
 		d.vertexData[i].visited = false
@@ -322,7 +471,7 @@ func (d *DFS[I, C, V, E]) FindCycles() [][]I {
 		d.vertexData[i].visiting = false
 	}
 
-	var cycles [][]I
+	var cycles []Cycle[I, C]
 	visitedInCycles := make(map[I]bool) // Track vertices already part of found cycles
 
 	// Check each unvisited vertex to find cycles
@@ -334,9 +483,9 @@ func (d *DFS[I, C, V, E]) FindCycles() [][]I {
 		if !vertexData.visited && !visitedInCycles[vertex.GetId()] {
 			cycle := d.findCycleFromVertex(vertex)
 			if cycle != nil {
-				cycles = append(cycles, cycle)
+				cycles = append(cycles, *cycle)
 				// Mark all vertices in this cycle as visited to avoid duplicates
-				for _, vertexId := range cycle {
+				for _, vertexId := range cycle.Vertices {
 					visitedInCycles[vertexId] = true
 				}
 			}
@@ -358,13 +507,16 @@ func (d *DFS[I, C, V, E]) HasCycle() bool {
 
 // findCycleFromVertex performs DFS from the given vertex to find cycles.
 // Uses the "visiting" state to detect back edges in the current path.
-// Returns the first cycle found as a slice of vertex IDs, or nil if no cycle.
-func (d *DFS[I, C, V, E]) findCycleFromVertex(startVertex *Vertex[I, C]) []I {
+// Returns the first cycle found, or nil if no cycle.
+func (d *DFS[I, C, V, E]) findCycleFromVertex(startVertex *Vertex[I, C]) *Cycle[I, C] {
 	// Use a stack to store vertices and their state
 	type stackItem struct {
 		vertex  *Vertex[I, C]
-		started bool // true if we've started processing this vertex
-		path    []I  // current path from start vertex
+		edge    *Edge[I, C] // edge that led to the vertex
+		started bool        // true if we've started processing this vertex
+		path    []I         // current path from start vertex
+		// Edges leading to the vertices of the path, nil for the start vertex
+		pathEdges []*Edge[I, C]
 	}
 
 	stack := []stackItem{{vertex: startVertex, started: false, path: []I{}}}
@@ -399,9 +551,15 @@ func (d *DFS[I, C, V, E]) findCycleFromVertex(startVertex *Vertex[I, C]) []I {
 					}
 				}
 				if cycleStart >= 0 {
-					// Return the cycle (from the back edge to the current vertex)
-					cycle := make([]I, len(item.path)-cycleStart)
-					copy(cycle, item.path[cycleStart:])
+					// Return the cycle (from the back edge to the current vertex),
+					// closed by the back edge itself
+					cycle := &Cycle[I, C]{
+						Vertices: make([]I, len(item.path)-cycleStart),
+						Edges:    make([]*Edge[I, C], 0, len(item.path)-cycleStart),
+					}
+					copy(cycle.Vertices, item.path[cycleStart:])
+					cycle.Edges = append(cycle.Edges, item.pathEdges[cycleStart+1:]...)
+					cycle.Edges = append(cycle.Edges, item.edge)
 					return cycle
 				}
 			}
@@ -413,9 +571,12 @@ func (d *DFS[I, C, V, E]) findCycleFromVertex(startVertex *Vertex[I, C]) []I {
 			newPath := make([]I, len(item.path)+1)
 			copy(newPath, item.path)
 			newPath[len(item.path)] = current.GetId()
+			newPathEdges := make([]*Edge[I, C], len(item.pathEdges)+1)
+			copy(newPathEdges, item.pathEdges)
+			newPathEdges[len(item.pathEdges)] = item.edge
 
 			// Push the vertex back to mark it as finished later
-			stack = append(stack, stackItem{vertex: current, started: true, path: newPath})
+			stack = append(stack, stackItem{vertex: current, started: true, path: newPath, pathEdges: newPathEdges})
 
 			// Add all neighbors to stack
 			edges := current.GetEdges()
@@ -426,7 +587,13 @@ func (d *DFS[I, C, V, E]) findCycleFromVertex(startVertex *Vertex[I, C]) []I {
 
 				// Only process unvisited neighbors
 				if !neighborData.visited {
-					stack = append(stack, stackItem{vertex: neighbor, started: false, path: newPath})
+					stack = append(stack, stackItem{
+						vertex:    neighbor,
+						edge:      &edges[i],
+						started:   false,
+						path:      newPath,
+						pathEdges: newPathEdges,
+					})
 				}
 			}
 		}
@@ -434,3 +601,80 @@ func (d *DFS[I, C, V, E]) findCycleFromVertex(startVertex *Vertex[I, C]) []I {
 
 	return nil
 }
+
+// EdgeType is the class of an edge in a depth-first search forest.
+type EdgeType int
+
+const (
+	// TreeEdge leads to a vertex discovered for the first time.
+	TreeEdge EdgeType = iota
+	// BackEdge leads to an ancestor still being explored (including the vertex itself),
+	// so it closes a cycle.
+	BackEdge
+	// ForwardEdge leads to an already finished descendant.
+	ForwardEdge
+	// CrossEdge leads to an already finished vertex that is neither an ancestor nor
+	// a descendant.
+	CrossEdge
+)
+
+// ClassifyEdges performs a depth-first search starting from the given vertex and
+// classifies every edge of the explored vertices as a tree, back, forward or cross edge
+// by the discovery and finish times of its endpoints. Back edges indicate cycles, which
+// makes the classification a building block for many algorithms.
+// All the edges are considered regardless of MaxBranch.
+// Returns nil if the start vertex doesn't exist.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *DFS[I, C, V, E]) ClassifyEdges(start I) map[*Edge[I, C]]EdgeType {
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil // Start vertex not found
+	}
+
+	// Zero means the vertex hasn't been discovered (or finished) yet
+	discovery := make([]int, len(d.graph.vertices))
+	finish := make([]int, len(d.graph.vertices))
+	time := 1
+
+	type stackItem struct {
+		vertexIdx int
+		edgeIdx   int // Index of the next outgoing edge to process
+	}
+	startIdx := startVertex.GetCustomDataIndex()
+	discovery[startIdx] = time
+	time++
+	stack := []stackItem{{vertexIdx: startIdx}}
+	classes := make(map[*Edge[I, C]]EdgeType)
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		edges := d.graph.vertices[top.vertexIdx].edges
+		if top.edgeIdx == len(edges) {
+			finish[top.vertexIdx] = time
+			time++
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		edge := &edges[top.edgeIdx]
+		top.edgeIdx++
+		neighborIdx := edge.targetVertex.GetCustomDataIndex()
+		switch {
+		case discovery[neighborIdx] == 0:
+			classes[edge] = TreeEdge
+			discovery[neighborIdx] = time
+			time++
+			stack = append(stack, stackItem{vertexIdx: neighborIdx})
+		case finish[neighborIdx] == 0:
+			classes[edge] = BackEdge
+		case discovery[top.vertexIdx] < discovery[neighborIdx]:
+			classes[edge] = ForwardEdge
+		default:
+			classes[edge] = CrossEdge
+		}
+	}
+
+	return classes
+}
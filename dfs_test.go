@@ -343,6 +343,137 @@ func TestDFSTraverseFrom(t *testing.T) {
 	})
 }
 
+func TestDFSTraverseFromUntil(t *testing.T) {
+	t.Run("Stops after callback returns false", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		var visitedVertices []int
+		stopped := false
+		dfs.TraverseFromUntil(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) bool {
+			if stopped {
+				t.Errorf("Unexpected callback for vertex %d after stop", vertex.GetId())
+			}
+			visitedVertices = append(visitedVertices, vertex.GetId())
+			if vertex.GetId() == 3 {
+				stopped = true
+				return false
+			}
+			return true
+		})
+
+		expected := []int{1, 2, 3}
+		if !slicesEqual(visitedVertices, expected) {
+			t.Errorf("Expected visited vertices %v, got %v", expected, visitedVertices)
+		}
+	})
+
+	t.Run("Visits everything when callback always returns true", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		count := 0
+		dfs.TraverseFromUntil(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) bool {
+			count++
+			return true
+		})
+
+		if count != 4 {
+			t.Errorf("Expected 4 callbacks, got %d", count)
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		dfs.TraverseFromUntil(999, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) bool {
+			t.Error("Expected no callbacks for non-existent start vertex")
+			return true
+		})
+	})
+}
+
+func TestDFSMaxBranch(t *testing.T) {
+	// Every vertex has a strong (cheap) edge and a weak (expensive) edge
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 5.0, "weak")
+	builder.AddEdge(1, 3, 1.0, "strong")
+	builder.AddEdge(3, 4, 1.0, "strong")
+	builder.AddEdge(3, 5, 9.0, "weak")
+	builder.AddEdge(4, 6, 2.0, "strong")
+	builder.AddEdge(4, 7, 3.0, "weak")
+	graph := builder.BuildDirected()
+
+	t.Run("Branching limited to the single best edge", func(t *testing.T) {
+		dfs := NewDFS(graph)
+		dfs.MaxBranch = 1
+
+		result := dfs.GetAllReachable(1)
+		expected := []int{1, 3, 4, 6}
+
+		if !slicesEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+
+		var edgeData []string
+		dfs.TraverseFrom(1, func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			if edge != nil {
+				data, _ := graph.GetEdgeData(edge)
+				edgeData = append(edgeData, *data)
+			}
+		})
+		for _, data := range edgeData {
+			if data != "strong" {
+				t.Errorf("Expected only strong edges to be followed, got %v", edgeData)
+				break
+			}
+		}
+	})
+
+	t.Run("Custom comparator", func(t *testing.T) {
+		dfs := NewDFS(graph)
+		dfs.MaxBranch = 1
+		// Prefer the most expensive edges
+		dfs.BranchLess = func(a, b *Edge[int, float64]) bool {
+			return a.GetCost() > b.GetCost()
+		}
+
+		result := dfs.GetAllReachable(1)
+		expected := []int{1, 2}
+
+		if !slicesEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+
+		if dfs.IsReachable(1, 4) {
+			t.Error("Expected vertex 4 to be unreachable through the most expensive edges")
+		}
+	})
+
+	t.Run("Unlimited by default", func(t *testing.T) {
+		dfs := NewDFS(graph)
+
+		if result := dfs.GetAllReachable(1); len(result) != 7 {
+			t.Errorf("Expected all 7 vertices, got %v", result)
+		}
+	})
+}
+
 func TestDFSFindPath(t *testing.T) {
 	t.Run("Find path between connected vertices", func(t *testing.T) {
 		builder := &Builder[int, float64, string, string]{}
@@ -968,6 +1099,75 @@ func TestDFSFindCycles(t *testing.T) {
 	})
 }
 
+func TestDFSFindCyclesWithEdges(t *testing.T) {
+	t.Run("Three-vertex cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 2.0, "edge2-3")
+		builder.AddEdge(3, 1, 3.0, "edge3-1")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		cycles := dfs.FindCyclesWithEdges()
+		if len(cycles) != 1 {
+			t.Fatalf("Expected 1 cycle, got %d", len(cycles))
+		}
+		cycle := cycles[0]
+		if !slicesEqual(cycle.Vertices, []int{1, 2, 3}) {
+			t.Errorf("Expected vertices [1 2 3], got %v", cycle.Vertices)
+		}
+		if len(cycle.Edges) != 3 {
+			t.Fatalf("Expected 3 edges, got %d", len(cycle.Edges))
+		}
+		expectedData := []string{"edge1-2", "edge2-3", "edge3-1"}
+		for i, edge := range cycle.Edges {
+			data, err := graph.GetEdgeData(edge)
+			if err != nil || *data != expectedData[i] {
+				t.Errorf("Expected edge %s, got %v", expectedData[i], data)
+			}
+			next := cycle.Vertices[(i+1)%len(cycle.Vertices)]
+			if edge.GetTargetVertex().GetId() != next {
+				t.Errorf("Expected edge %d to lead to %d, got %d", i, next, edge.GetTargetVertex().GetId())
+			}
+		}
+	})
+
+	t.Run("Self-loop", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 2, 2.0, "edge2-2")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		cycles := dfs.FindCyclesWithEdges()
+		if len(cycles) != 1 {
+			t.Fatalf("Expected 1 cycle, got %d", len(cycles))
+		}
+		cycle := cycles[0]
+		if !slicesEqual(cycle.Vertices, []int{2}) {
+			t.Errorf("Expected vertices [2], got %v", cycle.Vertices)
+		}
+		if len(cycle.Edges) != 1 {
+			t.Fatalf("Expected 1 edge, got %d", len(cycle.Edges))
+		}
+		if data, _ := graph.GetEdgeData(cycle.Edges[0]); *data != "edge2-2" {
+			t.Errorf("Expected edge edge2-2, got %s", *data)
+		}
+	})
+
+	t.Run("Acyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 2.0, "edge2-3")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		if cycles := dfs.FindCyclesWithEdges(); len(cycles) != 0 {
+			t.Errorf("Expected no cycles, got %v", cycles)
+		}
+	})
+}
+
 func TestDFSEmptyGraph(t *testing.T) {
 	t.Run("DFS operations on empty graph", func(t *testing.T) {
 		builder := &Builder[int, float64, string, string]{}
@@ -1035,3 +1235,195 @@ func TestDFSIterativeDeepGraph(t *testing.T) {
 		}
 	})
 }
+
+func TestDFSReset(t *testing.T) {
+	firstBuilder := &Builder[int, float64, string, string]{}
+	firstBuilder.AddEdge(1, 2, 1.0, "edge1-2")
+	firstGraph := firstBuilder.BuildDirected()
+
+	secondBuilder := &Builder[int, float64, string, string]{}
+	secondBuilder.AddEdge(1, 2, 1.0, "edge1-2")
+	secondBuilder.AddEdge(2, 3, 1.0, "edge2-3")
+	secondBuilder.AddEdge(3, 1, 1.0, "edge3-1")
+	secondGraph := secondBuilder.BuildDirected()
+
+	dfs := NewDFS(firstGraph)
+	if dfs.HasCycle() {
+		t.Error("Expected no cycle in the first graph")
+	}
+
+	dfs.Reset(secondGraph)
+	if !dfs.HasCycle() {
+		t.Error("Expected a cycle in the second graph")
+	}
+	if reachable := dfs.GetAllReachable(2); len(reachable) != 3 {
+		t.Errorf("Expected 3 reachable vertices, got %v", reachable)
+	}
+}
+
+func TestDFSClassifyEdges(t *testing.T) {
+	t.Run("One edge of each type", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "tree1-2")
+		builder.AddEdge(1, 3, 1.0, "forward1-3")
+		builder.AddEdge(1, 4, 1.0, "tree1-4")
+		builder.AddEdge(2, 3, 1.0, "tree2-3")
+		builder.AddEdge(3, 1, 1.0, "back3-1")
+		builder.AddEdge(4, 3, 1.0, "cross4-3")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		// The edges are explored in insertion order, so 3 is reached via 2 before 1->3
+		classes := dfs.ClassifyEdges(1)
+		expected := map[string]EdgeType{
+			"tree1-2":    TreeEdge,
+			"tree2-3":    TreeEdge,
+			"tree1-4":    TreeEdge,
+			"back3-1":    BackEdge,
+			"forward1-3": ForwardEdge,
+			"cross4-3":   CrossEdge,
+		}
+		if len(classes) != len(expected) {
+			t.Errorf("Expected %d classified edges, got %d", len(expected), len(classes))
+		}
+		for edge, class := range classes {
+			data, _ := graph.GetEdgeData(edge)
+			if expected[*data] != class {
+				t.Errorf("Expected edge %s to be %d, got %d", *data, expected[*data], class)
+			}
+		}
+	})
+
+	t.Run("Self-loop is a back edge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 1, 1.0, "edge1-1")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		classes := dfs.ClassifyEdges(1)
+		if len(classes) != 1 {
+			t.Fatalf("Expected 1 classified edge, got %d", len(classes))
+		}
+		for _, class := range classes {
+			if class != BackEdge {
+				t.Errorf("Expected a back edge, got %d", class)
+			}
+		}
+	})
+
+	t.Run("Only edges reachable from the start are classified", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		classes := dfs.ClassifyEdges(1)
+		if len(classes) != 1 {
+			t.Errorf("Expected 1 classified edge, got %d", len(classes))
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		if classes := dfs.ClassifyEdges(99); classes != nil {
+			t.Errorf("Expected nil, got %v", classes)
+		}
+	})
+}
+
+func TestDFSTraverseFromOrdered(t *testing.T) {
+	t.Run("Post-order of a chain is the reverse of the pre-order", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		var pre, post []int
+		dfs.TraverseFromOrdered(1,
+			func(vertex *Vertex[int, float64]) { pre = append(pre, vertex.GetId()) },
+			func(vertex *Vertex[int, float64]) { post = append(post, vertex.GetId()) },
+		)
+
+		if !slicesEqual(pre, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected pre-order [1 2 3 4], got %v", pre)
+		}
+		reversePath(post)
+		if !slicesEqual(post, pre) {
+			t.Errorf("Expected the reversed post-order to equal the pre-order %v, got %v", pre, post)
+		}
+	})
+
+	t.Run("Diamond", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		var pre, post []int
+		dfs.TraverseFromOrdered(1,
+			func(vertex *Vertex[int, float64]) { pre = append(pre, vertex.GetId()) },
+			func(vertex *Vertex[int, float64]) { post = append(post, vertex.GetId()) },
+		)
+
+		if !slicesEqual(pre, []int{1, 2, 4, 3}) {
+			t.Errorf("Expected pre-order [1 2 4 3], got %v", pre)
+		}
+		// A vertex finishes only after all its descendants
+		if !slicesEqual(post, []int{4, 2, 3, 1}) {
+			t.Errorf("Expected post-order [4 2 3 1], got %v", post)
+		}
+	})
+
+	t.Run("Nil callbacks and cycles", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		var post []int
+		dfs.TraverseFromOrdered(1, nil, func(vertex *Vertex[int, float64]) { post = append(post, vertex.GetId()) })
+		if !slicesEqual(post, []int{2, 1}) {
+			t.Errorf("Expected post-order [2 1], got %v", post)
+		}
+		dfs.TraverseFromOrdered(1, nil, nil)
+	})
+
+	t.Run("MaxBranch limits the explored edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+		dfs.MaxBranch = 1
+
+		var pre []int
+		dfs.TraverseFromOrdered(1, func(vertex *Vertex[int, float64]) { pre = append(pre, vertex.GetId()) }, nil)
+		if !slicesEqual(pre, []int{1, 3}) {
+			t.Errorf("Expected pre-order [1 3], got %v", pre)
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		called := false
+		dfs.TraverseFromOrdered(99, func(*Vertex[int, float64]) { called = true }, nil)
+		if called {
+			t.Errorf("Expected no callbacks for a non-existent start vertex")
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package graph
+
+// DialMaxEdgeCost is the maximum edge cost DialShortestPath allocates the buckets for.
+// Above it the memory for the buckets and the time to scan them outweigh the benefit
+// over the binary heap, so the search falls back to Dijkstra's algorithm.
+const DialMaxEdgeCost = 1 << 16
+
+// DialShortestPath finds the shortest path between two vertices with Dial's algorithm,
+// a variant of Dijkstra's algorithm for integer edge costs which replaces the binary
+// heap with a circular array of W+1 buckets, where W is the maximum edge cost. Since
+// every tentative distance is within W of the distance being settled, the bucket of a
+// vertex is its distance modulo W+1, and the buckets are scanned one distance at a time.
+// It's faster than Dijkstra's algorithm when the maximum edge cost is small, e.g. for
+// road networks with travel times in minutes. If the maximum edge cost exceeds
+// DialMaxEdgeCost, Dijkstra's algorithm is used instead, with the same result.
+// The cost type must be an integer type, and the edge costs must be non-negative:
+// nil and zero are returned if the graph has a negative edge cost.
+// Returns the path and its total cost, or nil and zero if no path is found.
+// Time complexity: O(E + V * W) where E is the number of edges, V is the number of vertices
+// and W is the maximum edge cost.
+// Space complexity: O(V + W) where V is the number of vertices and W is the maximum edge cost
+// (bounded by DialMaxEdgeCost).
+func DialShortestPath[I Id, C SInt | UInt, V any, E any](graph *Graph[I, C, V, E], start I, end I) ([]I, C) {
+	var zero C
+	startVertex, err := graph.GetVertexById(start)
+	if err != nil {
+		return nil, zero
+	}
+	endVertex, err := graph.GetVertexById(end)
+	if err != nil {
+		return nil, zero
+	}
+
+	var maxEdgeCost C
+	for i := range graph.vertices {
+		for _, edge := range graph.vertices[i].edges {
+			if edge.cost < 0 {
+				return nil, zero
+			}
+			if edge.cost > maxEdgeCost {
+				maxEdgeCost = edge.cost
+			}
+		}
+	}
+
+	if uint64(maxEdgeCost) > DialMaxEdgeCost {
+		dijkstra := NewDijkstra(graph)
+		path := dijkstra.FindShortestPath(start, end)
+		if path == nil {
+			return nil, zero
+		}
+		return path, dijkstra.vertexData[endVertex.GetCustomDataIndex()].cost
+	}
+
+	vertexCount := len(graph.vertices)
+	distance := make([]C, vertexCount)
+	reached := make([]bool, vertexCount)
+	settled := make([]bool, vertexCount)
+	previous := make([]int, vertexCount)
+	startIdx, endIdx := startVertex.GetCustomDataIndex(), endVertex.GetCustomDataIndex()
+	reached[startIdx] = true
+	previous[startIdx] = -1
+
+	bucketCount := uint64(maxEdgeCost) + 1
+	buckets := make([][]int, bucketCount)
+	buckets[0] = append(buckets[0], startIdx)
+	pending := 1
+	for current, bucketIdx := zero, uint64(0); pending > 0 && !settled[endIdx]; {
+		// All the vertices in the bucket are at the current distance, except for the
+		// outdated entries of the vertices that have been settled closer already
+		for len(buckets[bucketIdx]) > 0 && !settled[endIdx] {
+			last := len(buckets[bucketIdx]) - 1
+			currentIdx := buckets[bucketIdx][last]
+			buckets[bucketIdx] = buckets[bucketIdx][:last]
+			pending--
+			if settled[currentIdx] {
+				continue
+			}
+			settled[currentIdx] = true
+
+			for _, edge := range graph.vertices[currentIdx].edges {
+				neighborIdx := edge.targetVertex.GetCustomDataIndex()
+				if settled[neighborIdx] {
+					continue
+				}
+				tentativeDistance := saturatingAdd(current, edge.cost)
+				if !reached[neighborIdx] || tentativeDistance < distance[neighborIdx] {
+					reached[neighborIdx] = true
+					distance[neighborIdx] = tentativeDistance
+					previous[neighborIdx] = currentIdx
+					targetBucket := uint64(tentativeDistance) % bucketCount
+					buckets[targetBucket] = append(buckets[targetBucket], neighborIdx)
+					pending++
+				}
+			}
+		}
+		current++
+		bucketIdx = (bucketIdx + 1) % bucketCount
+	}
+
+	if !reached[endIdx] {
+		return nil, zero
+	}
+
+	var path []I
+	for idx := endIdx; idx >= 0; idx = previous[idx] {
+		path = append(path, graph.vertices[idx].id)
+	}
+	reversePath(path)
+
+	return path, distance[endIdx]
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDialShortestPath(t *testing.T) {
+	t.Run("Matches Dijkstra on integer costs", func(t *testing.T) {
+		builder := &Builder[uint32, uint16, string, string]{}
+		builder.AddVertex(1, "First")
+		builder.AddVertex(2, "Second")
+		builder.AddVertex(3, "Third")
+		builder.AddEdge(1, 2, 100, "edge1")
+		builder.AddEdge(2, 3, 200, "edge2")
+		builder.AddEdge(1, 3, 250, "direct")
+
+		graph := builder.BuildDirected()
+		expectedPath := NewDijkstra(graph).FindShortestPath(1, 3)
+		path, cost := DialShortestPath(graph, 1, 3)
+
+		if len(path) != len(expectedPath) {
+			t.Fatalf("Expected path %v, got %v", expectedPath, path)
+		}
+		for i, vertex := range path {
+			if vertex != expectedPath[i] {
+				t.Errorf("Expected vertex %d at position %d, got %d", expectedPath[i], i, vertex)
+			}
+		}
+		if cost != 250 {
+			t.Errorf("Expected cost 250, got %d", cost)
+		}
+	})
+
+	t.Run("Near-max uint16 costs don't overflow", func(t *testing.T) {
+		builder := &Builder[int, uint16, string, string]{}
+		builder.AddEdge(1, 2, 40000, "edge1-2")
+		builder.AddEdge(2, 3, 40000, "edge2-3")
+		builder.AddEdge(1, 3, 60000, "edge1-3")
+
+		graph := builder.BuildDirected()
+		path, cost := DialShortestPath(graph, 1, 3)
+
+		if !slicesEqual(path, []int{1, 3}) {
+			t.Errorf("Expected path [1 3], got %v", path)
+		}
+		if cost != 60000 {
+			t.Errorf("Expected cost 60000, got %d", cost)
+		}
+	})
+
+	t.Run("Matches Bellman-Ford on random graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(50, 0.1, seed,
+				func(origin int, target int) int { return (origin*31 + target) % 11 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			bellmanFord := NewBellmanFord(graph)
+
+			for end := 1; end < 50; end++ {
+				expectedPath := bellmanFord.FindShortestPath(0, end)
+				var expectedCost int
+				if expectedPath != nil {
+					expectedCost = bellmanFord.vertexData[graph.idToIndex[end]].cost
+				}
+				path, cost := DialShortestPath(graph, 0, end)
+				if (path == nil) != (expectedPath == nil) || cost != expectedCost {
+					t.Errorf("Seed %d, end %d: expected cost %d (path %v), got %d (path %v)",
+						seed, end, expectedCost, expectedPath, cost, path)
+				}
+			}
+		}
+	})
+
+	t.Run("Costs above the limit fall back to Dijkstra", func(t *testing.T) {
+		builder := &Builder[int, uint64, string, string]{}
+		builder.AddEdge(1, 2, 1<<40, "edge1-2")
+		builder.AddEdge(2, 3, 1, "edge2-3")
+		builder.AddEdge(1, 3, math.MaxUint64, "edge1-3")
+
+		graph := builder.BuildDirected()
+		path, cost := DialShortestPath(graph, 1, 3)
+
+		if !slicesEqual(path, []int{1, 2, 3}) || cost != 1<<40+1 {
+			t.Errorf("Expected path [1 2 3] with cost %d, got %v with cost %d", uint64(1<<40+1), path, cost)
+		}
+		if path, cost := DialShortestPath(graph, 3, 1); path != nil || cost != 0 {
+			t.Errorf("Expected nil path with cost 0, got %v with cost %d", path, cost)
+		}
+	})
+
+	t.Run("Zero costs", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 0, "edge1-2")
+		builder.AddEdge(2, 3, 0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		path, cost := DialShortestPath(graph, 1, 3)
+
+		if !slicesEqual(path, []int{1, 2, 3}) || cost != 0 {
+			t.Errorf("Expected path [1 2 3] with cost 0, got %v with cost %d", path, cost)
+		}
+	})
+
+	t.Run("Negative costs are rejected", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(2, 3, -1, "edge2-3")
+
+		graph := builder.BuildDirected()
+		if path, cost := DialShortestPath(graph, 1, 2); path != nil || cost != 0 {
+			t.Errorf("Expected nil path with cost 0, got %v with cost %d", path, cost)
+		}
+	})
+
+	t.Run("No path", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if path, cost := DialShortestPath(graph, 2, 1); path != nil || cost != 0 {
+			t.Errorf("Expected nil path with cost 0, got %v with cost %d", path, cost)
+		}
+	})
+}
@@ -2,8 +2,18 @@ package graph
 
 import (
 	"container/heap"
+	"context"
+	"errors"
 )
 
+// ErrNegativeEdgeCost is returned by the searches of Dijkstra in the StrictNonNegative
+// mode when they encounter a negative edge cost, which Dijkstra's algorithm can't handle.
+var ErrNegativeEdgeCost = errors.New("negative edge cost, use Bellman-Ford instead")
+
+// ctxCheckInterval is the number of main loop iterations between context
+// cancellation checks in the context-aware algorithm variants.
+const ctxCheckInterval = 1024
+
 // The Dijkstra algorithm Use-Case (aka Command) object.
 // It reuses the shared heap to limit the number of allocations during runtime,
 // but the consequence is that the algorithm is not thread-safe. You need a
@@ -21,7 +31,18 @@ type Dijkstra[I Id, C Cost, V any, E any] struct {
 	// GetCustomDataIndex() method.
 	vertexData []dijkstraVertexData[I, C]
 	maxCost    C
+	// The optional snapshot which cost overrides are consulted during relaxation.
+	snapshot *GraphSnapshot[I, C, V, E]
+	// The optional connected components of the graph used to reject the vertex pairs
+	// that can't be connected without searching.
+	components *ConnectedComponents[I, C, V, E]
 	Amplifier  CostFunc[I, C, V, E]
+	// StrictNonNegative makes all the searches validate the effective edge costs (after
+	// the snapshot overrides and the Amplifier) and fail instead of silently returning
+	// a wrong result when a negative cost is encountered: the methods returning an error
+	// return ErrNegativeEdgeCost, and the others return an empty result. Only the edges
+	// explored by the search are validated. Off by default.
+	StrictNonNegative bool
 }
 
 // Creates a new Dijkstra instance for the given graph.
@@ -35,52 +56,156 @@ func NewDijkstra[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *Dijkstra
 			algorithm: nil,
 		},
 		vertexData: vertexData,
+		maxCost:    maxCost[C](),
 	}
-	assignMaxNumber(&algorithm.maxCost)
 	algorithm.heap.algorithm = algorithm
 	return algorithm
 }
 
+// Creates a new Dijkstra instance for the graph of the given snapshot.
+// The edge costs overridden in the snapshot take precedence over the original ones,
+// and the Amplifier, if set, receives the edges with the overridden costs, so it
+// amplifies the snapshot's costs rather than the original ones.
+// This function is thread-safe and can be called concurrently as long as the
+// graph and the snapshot don't change.
+func NewDijkstraForSnapshot[I Id, C Cost, V any, E any](snapshot *GraphSnapshot[I, C, V, E]) *Dijkstra[I, C, V, E] {
+	algorithm := NewDijkstra(snapshot.graph)
+	algorithm.snapshot = snapshot
+	return algorithm
+}
+
+// Creates a new Dijkstra instance for the given graph with its precomputed connected
+// components (see FindConnectedComponents). Path searches between vertices of different
+// components return nil instantly instead of exploring the whole component of the start
+// vertex. The components must be computed for the same graph.
+// This function is thread-safe and can be called concurrently as long as the
+// graph doesn't change.
+func NewDijkstraWithComponents[I Id, C Cost, V any, E any](
+	graph *Graph[I, C, V, E],
+	components *ConnectedComponents[I, C, V, E],
+) *Dijkstra[I, C, V, E] {
+	algorithm := NewDijkstra(graph)
+	algorithm.components = components
+	return algorithm
+}
+
+// Reset rebinds the Dijkstra instance to another graph, so that one instance can be
+// reused across many graphs in batch workloads. The vertex data slice is reallocated
+// only when the new graph has more vertices than the current capacity.
+// The snapshot set by NewDijkstraForSnapshot and the components set by NewDijkstraWithComponents
+// are dropped, since they belong to the previous graph.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) Reset(graph *Graph[I, C, V, E]) {
+	// Drop the vertices of the previous graph possibly left in the heap, while their
+	// data is still in place
+	d.heap.clear()
+	d.graph = graph
+	if cap(d.vertexData) >= len(graph.vertices) {
+		d.vertexData = d.vertexData[:len(graph.vertices)]
+	} else {
+		d.vertexData = make([]dijkstraVertexData[I, C], len(graph.vertices))
+	}
+	d.snapshot = nil
+	d.components = nil
+}
+
 // Finds the shortest path between two vertices in the graph.
 // Returns a slice of vertex IDs representing the shortest path.
-// Returns nil if no path is found.
+// Returns nil if no path is found, or if StrictNonNegative is set and a negative edge
+// cost is encountered (use FindShortestPathCtx to tell these cases apart).
 // Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
 // Space complexity: O(V) where V is the number of vertices.
 // WARNING: This function is not thread-safe and should not be called concurrently.
 func (d *Dijkstra[I, C, V, E]) FindShortestPath(start I, end I) []I {
+	path, _ := d.findShortestPath(context.Background(), start, end)
+	return path
+}
+
+// FindShortestPathCtx finds the shortest path like FindShortestPath, but periodically
+// checks the context and aborts the search as soon as it's cancelled, which prevents
+// wasting resources when e.g. the client of a server has disconnected.
+// Returns nil and the context error if the context has been cancelled, or nil and
+// ErrNegativeEdgeCost if StrictNonNegative is set and a negative edge cost is encountered.
+// Otherwise returns the same path as FindShortestPath and a nil error.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) FindShortestPathCtx(ctx context.Context, start I, end I) ([]I, error) {
+	return d.findShortestPath(ctx, start, end)
+}
+
+// FindShortestPathEdges finds the shortest path like FindShortestPath, but returns the
+// edges the path consists of rather than the vertex IDs, so that their custom data can
+// be accessed without looking them up again. If the vertices are connected by parallel
+// edges, the exact edge chosen during the search is returned.
+// Returns an empty slice if start and end are the same vertex, or nil if no path is found.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) FindShortestPathEdges(start I, end I) []*Edge[I, C] {
+	path, _ := d.findShortestPath(context.Background(), start, end)
+	if path == nil {
+		return nil
+	}
+
+	edges := make([]*Edge[I, C], len(path)-1)
+	data := &d.vertexData[d.graph.idToIndex[end]]
+	for i := len(edges) - 1; i >= 0; i-- {
+		edges[i] = data.previousEdge
+		data = &d.vertexData[data.previous.GetCustomDataIndex()]
+	}
+	return edges
+}
+
+// findShortestPath implements FindShortestPath and FindShortestPathCtx.
+func (d *Dijkstra[I, C, V, E]) findShortestPath(ctx context.Context, start I, end I) ([]I, error) {
 	// Check if start and end vertices exist
 	startVertex, err := d.graph.GetVertexById(start)
 	if err != nil {
-		return nil // Start vertex not found
+		return nil, nil // Start vertex not found
 	}
 
 	endVertex, err := d.graph.GetVertexById(end)
 	if err != nil {
-		return nil // End vertex not found
+		return nil, nil // End vertex not found
 	}
 
 	// If start and end are the same, return the start vertex
 	if start == end {
-		return []I{start}
+		return []I{start}, nil
+	}
+
+	if d.areDisconnected(startVertex, endVertex) {
+		return nil, nil // No path can exist
 	}
 
 	// Initialize vertex data for all vertices
 	for i := range d.vertexData {
 		d.vertexData[i].visited = false
+		d.vertexData[i].reached = false
 		d.vertexData[i].previous = nil
+		d.vertexData[i].previousEdge = nil
 		d.vertexData[i].cost = d.maxCost
 	}
 
 	// Initialize priority queue
-	heap.Init(d.heap)
+	d.heap.clear()
 
 	// Set start vertex distance to 0 and add to queue
 	startIdx := startVertex.GetCustomDataIndex()
 	d.vertexData[startIdx].cost = 0
+	d.vertexData[startIdx].reached = true
 	heap.Push(d.heap, startVertex)
 
 	// Main Dijkstra loop
-	for d.heap.Len() > 0 {
+	for iteration := 0; d.heap.Len() > 0; iteration++ {
+		// Check for cancellation once in a while, as it's relatively expensive
+		if iteration%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
 		// Get vertex with minimum distance
 		current := heap.Pop(d.heap).(*Vertex[I, C])
 		currentIdx := current.GetCustomDataIndex()
@@ -100,34 +225,35 @@ func (d *Dijkstra[I, C, V, E]) FindShortestPath(start I, end I) []I {
 		}
 
 		// Process all neighbors
-		for _, edge := range current.edges {
+		for i, edge := range current.edges {
 			neighbor := edge.targetVertex
 			neighborIdx := neighbor.GetCustomDataIndex()
 			neighborData := &d.vertexData[neighborIdx]
 
-			// Skip if neighbor already visited
-			if neighborData.visited {
+			// Skip if neighbor already visited, unless the edge has to be validated,
+			// since a negative edge leading to a visited vertex breaks the result too
+			if neighborData.visited && !d.StrictNonNegative {
 				continue
 			}
 
-			edgeCost := edge.cost
-
-			if d.Amplifier != nil {
-				cost, enabled := d.Amplifier(current, &edge)
-				if !enabled {
-					continue
-				}
-				edgeCost = cost
+			edgeCost, enabled, err := d.edgeCost(current, &edge)
+			if err != nil {
+				return nil, err
+			}
+			if !enabled || neighborData.visited {
+				continue
 			}
 
 			// Calculate tentative distance
-			tentativeDistance := currentData.cost + edgeCost
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
 
 			// If this is a better path to the neighbor
-			if tentativeDistance < neighborData.cost {
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
 				neighborData.cost = tentativeDistance
 				neighborData.previous = current
-				heap.Push(d.heap, neighbor)
+				neighborData.previousEdge = &current.edges[i]
+				d.heap.pushOrFix(neighbor)
 			}
 		}
 	}
@@ -135,7 +261,7 @@ func (d *Dijkstra[I, C, V, E]) FindShortestPath(start I, end I) []I {
 	// Reconstruct path by following previous pointers
 	endIdx := endVertex.GetCustomDataIndex()
 	if !d.vertexData[endIdx].visited {
-		return nil // No path found
+		return nil, nil // No path found
 	}
 
 	path := []I{}
@@ -147,9 +273,311 @@ func (d *Dijkstra[I, C, V, E]) FindShortestPath(start I, end I) []I {
 	}
 
 	// Reverse the path to get start-to-end order
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
+	reversePath(path)
+
+	return path, nil
+}
+
+// Finds all the vertices reachable from the start vertex within the given maximum cost,
+// e.g. for "find everything within radius R" queries.
+// The search prunes any vertex whose tentative distance exceeds maxCost, so only the
+// neighborhood of the start vertex is explored. The Amplifier and the snapshot cost
+// overrides are respected the same way as by FindShortestPath.
+// Returns the reachable vertices (including the start one) mapped to their distances,
+// or nil if the start vertex doesn't exist or if StrictNonNegative is set and a negative
+// edge cost is encountered.
+// Time complexity: O(E' log V') where E' and V' are the numbers of edges and vertices within the radius.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) ReachableWithin(start I, maxCost C) map[I]C {
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil // Start vertex not found
 	}
 
-	return path
+	// Initialize vertex data for all vertices
+	for i := range d.vertexData {
+		d.vertexData[i].visited = false
+		d.vertexData[i].reached = false
+		d.vertexData[i].previous = nil
+		d.vertexData[i].cost = d.maxCost
+	}
+
+	d.heap.clear()
+	startIdx := startVertex.GetCustomDataIndex()
+	d.vertexData[startIdx].cost = 0
+	d.vertexData[startIdx].reached = true
+	heap.Push(d.heap, startVertex)
+
+	reachable := make(map[I]C)
+	for d.heap.Len() > 0 {
+		current := heap.Pop(d.heap).(*Vertex[I, C])
+		currentData := &d.vertexData[current.GetCustomDataIndex()]
+		if currentData.visited {
+			continue
+		}
+		currentData.visited = true
+		reachable[current.id] = currentData.cost
+
+		for _, edge := range current.edges {
+			neighbor := edge.targetVertex
+			neighborData := &d.vertexData[neighbor.GetCustomDataIndex()]
+			if neighborData.visited && !d.StrictNonNegative {
+				continue
+			}
+
+			edgeCost, enabled, err := d.edgeCost(current, &edge)
+			if err != nil {
+				return nil // Negative edge cost
+			}
+			if !enabled || neighborData.visited {
+				continue
+			}
+
+			// Prune the vertices beyond the radius
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
+			if tentativeDistance > maxCost {
+				continue
+			}
+
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
+				neighborData.cost = tentativeDistance
+				neighborData.previous = current
+				d.heap.pushOrFix(neighbor)
+			}
+		}
+	}
+
+	return reachable
+}
+
+// FindNearestSource finds which of the source vertices is the nearest to the target one,
+// e.g. for "nearest facility" queries. All the sources are pushed into the heap with
+// the zero distance, so a single search answers the query instead of one per source.
+// If several sources are equally near, any of them may be returned. The non-existent
+// sources are ignored. The Amplifier and the snapshot cost overrides are respected
+// as by FindShortestPath.
+// Returns the nearest source, the path from it to the target and its cost, or the zero
+// ID, nil and zero if the target doesn't exist or isn't reachable from any source, or
+// if StrictNonNegative is set and a negative edge cost is encountered.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) FindNearestSource(sources []I, target I) (I, []I, C) {
+	var zeroId I
+	var zeroCost C
+	targetVertex, err := d.graph.GetVertexById(target)
+	if err != nil {
+		return zeroId, nil, zeroCost // Target vertex not found
+	}
+
+	// Initialize vertex data for all vertices
+	for i := range d.vertexData {
+		d.vertexData[i].visited = false
+		d.vertexData[i].reached = false
+		d.vertexData[i].previous = nil
+		d.vertexData[i].cost = d.maxCost
+	}
+
+	d.heap.clear()
+	for _, source := range sources {
+		sourceVertex, err := d.graph.GetVertexById(source)
+		if err != nil {
+			continue // Source vertex not found
+		}
+		sourceData := &d.vertexData[sourceVertex.GetCustomDataIndex()]
+		if !sourceData.reached {
+			sourceData.cost = 0
+			sourceData.reached = true
+			heap.Push(d.heap, sourceVertex)
+		}
+	}
+
+	for d.heap.Len() > 0 {
+		current := heap.Pop(d.heap).(*Vertex[I, C])
+		currentData := &d.vertexData[current.GetCustomDataIndex()]
+		if currentData.visited {
+			continue
+		}
+		currentData.visited = true
+		if current == targetVertex {
+			break
+		}
+
+		for _, edge := range current.edges {
+			neighbor := edge.targetVertex
+			neighborData := &d.vertexData[neighbor.GetCustomDataIndex()]
+			if neighborData.visited && !d.StrictNonNegative {
+				continue
+			}
+
+			edgeCost, enabled, err := d.edgeCost(current, &edge)
+			if err != nil {
+				return zeroId, nil, zeroCost // Negative edge cost
+			}
+			if !enabled || neighborData.visited {
+				continue
+			}
+
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
+				neighborData.cost = tentativeDistance
+				neighborData.previous = current
+				d.heap.pushOrFix(neighbor)
+			}
+		}
+	}
+
+	targetData := &d.vertexData[targetVertex.GetCustomDataIndex()]
+	if !targetData.visited {
+		return zeroId, nil, zeroCost // No path found
+	}
+
+	// The path leads back to the source it was started from
+	path := []I{}
+	for current := targetVertex; current != nil; current = d.vertexData[current.GetCustomDataIndex()].previous {
+		path = append(path, current.id)
+	}
+	reversePath(path)
+
+	return path[0], path, targetData.cost
+}
+
+// areDisconnected reports whether the vertices are known to lie in different connected
+// components, so that no path can exist between them. Always false without components.
+func (d *Dijkstra[I, C, V, E]) areDisconnected(startVertex *Vertex[I, C], endVertex *Vertex[I, C]) bool {
+	if d.components == nil {
+		return false
+	}
+	labels := d.components.labels
+	return labels[startVertex.GetCustomDataIndex()] != labels[endVertex.GetCustomDataIndex()]
+}
+
+// edgeCost returns the cost of the edge as seen by the algorithm (see effectiveEdgeCost).
+// Returns false if the edge is disabled, or ErrNegativeEdgeCost if StrictNonNegative is
+// set and the cost is negative.
+func (d *Dijkstra[I, C, V, E]) edgeCost(origin *Vertex[I, C], edge *Edge[I, C]) (C, bool, error) {
+	cost, enabled := d.effectiveEdgeCost(origin, edge)
+	if enabled && d.StrictNonNegative && cost < 0 {
+		return cost, false, ErrNegativeEdgeCost
+	}
+	return cost, enabled, nil
+}
+
+// effectiveEdgeCost applies the snapshot cost override first, and then gives the
+// Amplifier a copy of the edge with the overridden cost. Returns false if the edge
+// is disabled.
+func (d *Dijkstra[I, C, V, E]) effectiveEdgeCost(origin *Vertex[I, C], edge *Edge[I, C]) (C, bool) {
+	if d.snapshot != nil {
+		cost := d.snapshot.GetEdgeCost(origin, edge)
+		if d.Amplifier == nil {
+			return cost, true
+		}
+		overridden := *edge
+		overridden.cost = cost
+		return d.Amplifier(origin, &overridden)
+	}
+	if d.Amplifier != nil {
+		return d.Amplifier(origin, edge)
+	}
+	return edge.cost, true
+}
+
+// Finds the shortest path between two vertices in the graph where passing through
+// a vertex has a cost too, e.g. a toll. The vertexCost callback is called for every
+// intermediate vertex when it's expanded, and its cost is added to the accumulated
+// distance, so both the edges and the vertices contribute to the total. The start and
+// end vertices are never charged. Vertex costs must be non-negative.
+// The Amplifier and the snapshot cost overrides are respected as by FindShortestPath.
+// Returns the path and its total cost, or nil and zero if no path is found or if
+// StrictNonNegative is set and a negative edge cost is encountered.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) FindShortestPathWithVertexCost(start I, end I, vertexCost func(*Vertex[I, C]) C) ([]I, C) {
+	var zero C
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil, zero // Start vertex not found
+	}
+	endVertex, err := d.graph.GetVertexById(end)
+	if err != nil {
+		return nil, zero // End vertex not found
+	}
+	if start == end {
+		return []I{start}, zero
+	}
+	if d.areDisconnected(startVertex, endVertex) {
+		return nil, zero // No path can exist
+	}
+
+	for i := range d.vertexData {
+		d.vertexData[i].visited = false
+		d.vertexData[i].reached = false
+		d.vertexData[i].previous = nil
+		d.vertexData[i].cost = d.maxCost
+	}
+
+	d.heap.clear()
+	startIdx := startVertex.GetCustomDataIndex()
+	d.vertexData[startIdx].cost = 0
+	d.vertexData[startIdx].reached = true
+	heap.Push(d.heap, startVertex)
+
+	for d.heap.Len() > 0 {
+		current := heap.Pop(d.heap).(*Vertex[I, C])
+		currentData := &d.vertexData[current.GetCustomDataIndex()]
+		if currentData.visited {
+			continue
+		}
+		currentData.visited = true
+		if current == endVertex {
+			break
+		}
+
+		// Pay the toll for passing through the vertex
+		expandedCost := currentData.cost
+		if current != startVertex {
+			expandedCost = saturatingAdd(expandedCost, vertexCost(current))
+		}
+
+		for _, edge := range current.edges {
+			neighbor := edge.targetVertex
+			neighborData := &d.vertexData[neighbor.GetCustomDataIndex()]
+			if neighborData.visited && !d.StrictNonNegative {
+				continue
+			}
+
+			edgeCost, enabled, err := d.edgeCost(current, &edge)
+			if err != nil {
+				return nil, zero // Negative edge cost
+			}
+			if !enabled || neighborData.visited {
+				continue
+			}
+
+			tentativeDistance := saturatingAdd(expandedCost, edgeCost)
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
+				neighborData.cost = tentativeDistance
+				neighborData.previous = current
+				d.heap.pushOrFix(neighbor)
+			}
+		}
+	}
+
+	endData := &d.vertexData[endVertex.GetCustomDataIndex()]
+	if !endData.visited {
+		return nil, zero // No path found
+	}
+
+	path := []I{}
+	for current := endVertex; current != nil; current = d.vertexData[current.GetCustomDataIndex()].previous {
+		path = append(path, current.id)
+	}
+	reversePath(path)
+
+	return path, endData.cost
 }
@@ -1,13 +1,24 @@
 package graph
 
+import "container/heap"
+
 // The data that is attached to the vertices by the Dijkstra algorithms.
 type dijkstraVertexData[I Id, C Cost] struct {
 	previous *Vertex[I, C]
-	visited  bool
-	cost     C
+	// The exact edge leading from the previous vertex, which matters for parallel edges
+	previousEdge *Edge[I, C]
+	visited      bool
+	// Whether the cost has been set, so any value of the cost type is a valid distance
+	reached bool
+	cost    C
+	// The position of the vertex in the heap plus one, or zero if it isn't in the heap
+	heapPos int
 }
 
-// dijkstraHeap implements heap.Interface for the priority queue
+// dijkstraHeap implements heap.Interface for the priority queue.
+// It's an indexed heap: the position of every vertex is tracked in its vertex data,
+// so when the cost of a vertex in the heap decreases, its entry is moved up instead
+// of pushing a duplicate, and each vertex is in the heap at most once.
 type dijkstraHeap[I Id, C Cost, V any, E any] struct {
 	pq        []*Vertex[I, C]
 	algorithm *Dijkstra[I, C, V, E]
@@ -27,10 +38,14 @@ func (h *dijkstraHeap[I, C, V, E]) Less(i, j int) bool {
 
 func (h *dijkstraHeap[I, C, V, E]) Swap(i, j int) {
 	h.pq[i], h.pq[j] = h.pq[j], h.pq[i]
+	h.algorithm.vertexData[h.pq[i].GetCustomDataIndex()].heapPos = i + 1
+	h.algorithm.vertexData[h.pq[j].GetCustomDataIndex()].heapPos = j + 1
 }
 
 func (h *dijkstraHeap[I, C, V, E]) Push(x any) {
-	h.pq = append(h.pq, x.(*Vertex[I, C]))
+	vertex := x.(*Vertex[I, C])
+	h.pq = append(h.pq, vertex)
+	h.algorithm.vertexData[vertex.GetCustomDataIndex()].heapPos = len(h.pq)
 }
 
 func (h *dijkstraHeap[I, C, V, E]) Pop() any {
@@ -38,5 +53,26 @@ func (h *dijkstraHeap[I, C, V, E]) Pop() any {
 	node := h.pq[n-1]
 	h.pq[n-1] = nil // avoid memory leak
 	h.pq = h.pq[0 : n-1]
+	h.algorithm.vertexData[node.GetCustomDataIndex()].heapPos = 0
 	return node
 }
+
+// pushOrFix adds the vertex to the heap, or restores the heap order after its cost
+// has decreased if the vertex is in the heap already.
+func (h *dijkstraHeap[I, C, V, E]) pushOrFix(vertex *Vertex[I, C]) {
+	if pos := h.algorithm.vertexData[vertex.GetCustomDataIndex()].heapPos; pos > 0 {
+		heap.Fix(h, pos-1)
+	} else {
+		heap.Push(h, vertex)
+	}
+}
+
+// clear removes all the vertices from the heap, e.g. the ones left by a search that
+// stopped early.
+func (h *dijkstraHeap[I, C, V, E]) clear() {
+	for i, vertex := range h.pq {
+		h.algorithm.vertexData[vertex.GetCustomDataIndex()].heapPos = 0
+		h.pq[i] = nil // avoid memory leak
+	}
+	h.pq = h.pq[:0]
+}
@@ -0,0 +1,48 @@
+package graph
+
+import "sync"
+
+// DijkstraPool runs independent shortest path queries against one graph concurrently.
+// The Dijkstra instances aren't thread-safe because of their reusable vertex data, so
+// the pool hands every query an instance of its own, recycling the instances with
+// a sync.Pool to keep the allocations low.
+// The graph must not change while the pool is in use.
+type DijkstraPool[I Id, C Cost, V any, E any] struct {
+	graph *Graph[I, C, V, E]
+	pool  sync.Pool
+	// Limits the number of the queries running at the same time, nil if unlimited
+	slots chan struct{}
+}
+
+// Creates a new DijkstraPool for the given graph. The workers argument limits the number
+// of the queries running at the same time, and so the number of the Dijkstra instances
+// (and their vertex data) alive at once; the other queries wait for a free slot.
+// A non-positive number of workers means no limit.
+// This function is thread-safe and can be called concurrently as long as the
+// graph doesn't change.
+func NewDijkstraPool[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E], workers int) *DijkstraPool[I, C, V, E] {
+	p := &DijkstraPool[I, C, V, E]{graph: graph}
+	p.pool.New = func() any {
+		return NewDijkstra(graph)
+	}
+	if workers > 0 {
+		p.slots = make(chan struct{}, workers)
+	}
+	return p
+}
+
+// Query finds the shortest path between two vertices like Dijkstra.FindShortestPath.
+// Returns a slice of vertex IDs representing the shortest path, or nil if no path is found.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+// This function is thread-safe and can be called concurrently as long as the
+// graph doesn't change.
+func (p *DijkstraPool[I, C, V, E]) Query(start I, end I) []I {
+	if p.slots != nil {
+		p.slots <- struct{}{}
+		defer func() { <-p.slots }()
+	}
+	dijkstra := p.pool.Get().(*Dijkstra[I, C, V, E])
+	defer p.pool.Put(dijkstra)
+	return dijkstra.FindShortestPath(start, end)
+}
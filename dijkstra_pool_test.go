@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestDijkstraPool(t *testing.T) {
+	graph := GenerateRandom(200, 0.05, 1,
+		func(origin int, target int) float64 { return float64((origin*31+target)%20 + 1) },
+		func(id int) string { return "vertex" },
+		func(origin int, target int) string { return "edge" },
+	)
+
+	rng := rand.New(rand.NewSource(1))
+	queries := make([][2]int, 1000)
+	for i := range queries {
+		queries[i] = [2]int{rng.Intn(200), rng.Intn(200)}
+	}
+
+	// The same Dijkstra instance answering the queries one by one
+	dijkstra := NewDijkstra(graph)
+	expected := make([][]int, len(queries))
+	for i, query := range queries {
+		expected[i] = dijkstra.FindShortestPath(query[0], query[1])
+	}
+
+	for _, workers := range []int{4, 0} {
+		pool := NewDijkstraPool(graph, workers)
+		results := make([][]int, len(queries))
+		var wg sync.WaitGroup
+		for i := range queries {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i] = pool.Query(queries[i][0], queries[i][1])
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range queries {
+			if (results[i] == nil) != (expected[i] == nil) ||
+				(len(results[i]) > 0 && !slicesEqual(results[i], expected[i])) {
+				t.Errorf("Workers %d, query %v: expected path %v, got %v", workers, queries[i], expected[i], results[i])
+			}
+		}
+	}
+}
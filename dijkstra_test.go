@@ -1,7 +1,10 @@
 package graph
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestNewDijkstra(t *testing.T) {
@@ -327,6 +330,40 @@ func TestDijkstraWithDifferentTypes(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Saturated uint8 costs", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		builder.AddEdge(1, 2, 200, "edge1-2")
+		builder.AddEdge(2, 3, 55, "edge2-3")
+		builder.AddEdge(3, 4, 0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		// The distance of 255 equals the max value of uint8, yet vertex 4 is reachable
+		path := dijkstra.FindShortestPath(1, 4)
+		expectedPath := []int{1, 2, 3, 4}
+		if !slicesEqual(path, expectedPath) {
+			t.Errorf("Expected path %v, got %v", expectedPath, path)
+		}
+	})
+
+	t.Run("Near-max uint16 costs don't overflow", func(t *testing.T) {
+		builder := &Builder[int, uint16, string, string]{}
+		builder.AddEdge(1, 2, 40000, "edge1-2")
+		builder.AddEdge(2, 3, 40000, "edge2-3")
+		builder.AddEdge(1, 3, 60000, "edge1-3")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		// 40000 + 40000 would wrap around to 14464 and win over the direct edge
+		path := dijkstra.FindShortestPath(1, 3)
+		expectedPath := []int{1, 3}
+		if !slicesEqual(path, expectedPath) {
+			t.Errorf("Expected path %v, got %v", expectedPath, path)
+		}
+	})
 }
 
 func TestDijkstraWithAmplifier(t *testing.T) {
@@ -545,3 +582,526 @@ func slicesEqual(a, b []int) bool {
 	}
 	return true
 }
+
+func TestDijkstraReset(t *testing.T) {
+	smallBuilder := &Builder[int, float64, string, string]{}
+	smallBuilder.AddEdge(1, 2, 1.0, "edge1-2")
+	smallBuilder.AddEdge(2, 3, 1.0, "edge2-3")
+	smallBuilder.AddEdge(1, 3, 5.0, "edge1-3")
+	smallGraph := smallBuilder.BuildDirected()
+
+	largeBuilder := &Builder[int, float64, string, string]{}
+	largeBuilder.AddEdge(10, 20, 4.0, "edge10-20")
+	largeBuilder.AddEdge(20, 50, 4.0, "edge20-50")
+	largeBuilder.AddEdge(10, 30, 1.0, "edge10-30")
+	largeBuilder.AddEdge(30, 40, 1.0, "edge30-40")
+	largeBuilder.AddEdge(40, 50, 1.0, "edge40-50")
+	largeGraph := largeBuilder.BuildDirected()
+
+	dijkstra := NewDijkstra(smallGraph)
+	if path := dijkstra.FindShortestPath(1, 3); !slicesEqual(path, []int{1, 2, 3}) {
+		t.Errorf("Expected path [1 2 3], got %v", path)
+	}
+
+	// The vertex data has to grow for the larger graph
+	dijkstra.Reset(largeGraph)
+	if path := dijkstra.FindShortestPath(10, 50); !slicesEqual(path, []int{10, 30, 40, 50}) {
+		t.Errorf("Expected path [10 30 40 50], got %v", path)
+	}
+	if path := dijkstra.FindShortestPath(1, 3); path != nil {
+		t.Errorf("Expected no path for vertices of the previous graph, got %v", path)
+	}
+
+	// And shrink back for the smaller one
+	dijkstra.Reset(smallGraph)
+	if path := dijkstra.FindShortestPath(1, 3); !slicesEqual(path, []int{1, 2, 3}) {
+		t.Errorf("Expected path [1 2 3] after reset, got %v", path)
+	}
+}
+
+func TestDijkstraReachableWithin(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	for i := 1; i < 8; i++ {
+		builder.AddEdge(i, i+1, 1.0, "chain")
+	}
+	graph := builder.BuildDirected()
+
+	t.Run("Chain with unit edges", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		reachable := dijkstra.ReachableWithin(1, 3.0)
+
+		expected := map[int]float64{1: 0, 2: 1, 3: 2, 4: 3}
+		if len(reachable) != len(expected) {
+			t.Errorf("Expected %d reachable vertices, got %v", len(expected), reachable)
+		}
+		for id, cost := range expected {
+			if actual, ok := reachable[id]; !ok || actual != cost {
+				t.Errorf("Expected vertex %d at distance %f, got %f (present: %v)", id, cost, actual, ok)
+			}
+		}
+	})
+
+	t.Run("Respects the amplifier", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		dijkstra.Amplifier = func(origin *Vertex[int, float64], edge *Edge[int, float64]) (float64, bool) {
+			if origin.GetId() == 2 {
+				return edge.GetCost(), false // Disable the edge 2->3
+			}
+			return edge.GetCost() * 0.5, true
+		}
+		reachable := dijkstra.ReachableWithin(1, 3.0)
+
+		if len(reachable) != 2 || reachable[2] != 0.5 {
+			t.Errorf("Expected vertices 1 and 2 at distance 0.5, got %v", reachable)
+		}
+	})
+
+	t.Run("Zero radius", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		reachable := dijkstra.ReachableWithin(5, 0.0)
+
+		if len(reachable) != 1 || reachable[5] != 0 {
+			t.Errorf("Expected only the start vertex, got %v", reachable)
+		}
+	})
+
+	t.Run("Non-existent start vertex", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+
+		if reachable := dijkstra.ReachableWithin(999, 3.0); reachable != nil {
+			t.Errorf("Expected nil, got %v", reachable)
+		}
+	})
+}
+
+func TestDijkstraFindShortestPathCtx(t *testing.T) {
+	graph := GenerateGrid[string, string](300, 300, false)
+
+	t.Run("Completes with a live context", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		path, err := dijkstra.FindShortestPathCtx(context.Background(), 0, 299)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(path) != 300 {
+			t.Errorf("Expected path of 300 vertices, got %d", len(path))
+		}
+	})
+
+	t.Run("Returns promptly when cancelled", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		started := time.Now()
+		path, err := dijkstra.FindShortestPathCtx(ctx, 0, 300*300-1)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if path != nil {
+			t.Errorf("Expected nil path, got %d vertices", len(path))
+		}
+		if elapsed := time.Since(started); elapsed > 100*time.Millisecond {
+			t.Errorf("Expected prompt return, took %v", elapsed)
+		}
+	})
+
+	t.Run("Stops at the deadline", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		if _, err := dijkstra.FindShortestPathCtx(ctx, 0, 300*300-1); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+func TestDijkstraFindShortestPathWithVertexCost(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 1.0, "edge1-2")
+	builder.AddEdge(2, 4, 1.0, "edge2-4")
+	builder.AddEdge(1, 3, 2.0, "edge1-3")
+	builder.AddEdge(3, 4, 2.0, "edge3-4")
+	graph := builder.BuildDirected()
+
+	t.Run("Cheap toll keeps the route", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		path, cost := dijkstra.FindShortestPathWithVertexCost(1, 4, func(vertex *Vertex[int, float64]) float64 {
+			return 1.0
+		})
+
+		if !slicesEqual(path, []int{1, 2, 4}) || cost != 3.0 {
+			t.Errorf("Expected path [1 2 4] of cost 3, got %v of cost %f", path, cost)
+		}
+	})
+
+	t.Run("Expensive toll reroutes the path", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		path, cost := dijkstra.FindShortestPathWithVertexCost(1, 4, func(vertex *Vertex[int, float64]) float64 {
+			if vertex.GetId() == 2 {
+				return 10.0
+			}
+			return 0.5
+		})
+
+		if !slicesEqual(path, []int{1, 3, 4}) || cost != 4.5 {
+			t.Errorf("Expected path [1 3 4] of cost 4.5, got %v of cost %f", path, cost)
+		}
+	})
+
+	t.Run("Start and end aren't charged", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		path, cost := dijkstra.FindShortestPathWithVertexCost(1, 2, func(vertex *Vertex[int, float64]) float64 {
+			return 100.0
+		})
+
+		if !slicesEqual(path, []int{1, 2}) || cost != 1.0 {
+			t.Errorf("Expected path [1 2] of cost 1, got %v of cost %f", path, cost)
+		}
+	})
+
+	t.Run("No path", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		path, cost := dijkstra.FindShortestPathWithVertexCost(4, 1, func(vertex *Vertex[int, float64]) float64 {
+			return 0
+		})
+
+		if path != nil || cost != 0 {
+			t.Errorf("Expected nil path of zero cost, got %v of cost %f", path, cost)
+		}
+	})
+}
+
+func TestNewDijkstraWithComponents(t *testing.T) {
+	buildTwoComponents := func() *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		// Component A: 1 -> 2 -> 3 and a longer detour 1 -> 4 -> 3
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 3, 1.0, "2-3")
+		builder.AddEdge(1, 4, 2.0, "1-4")
+		builder.AddEdge(4, 3, 2.0, "4-3")
+		// Component B
+		builder.AddEdge(5, 6, 1.0, "5-6")
+		return builder.BuildDirected()
+	}
+
+	t.Run("Different components return nil without searching", func(t *testing.T) {
+		graph := buildTwoComponents()
+		dijkstra := NewDijkstraWithComponents(graph, FindConnectedComponents(graph))
+		examined := 0
+		dijkstra.Amplifier = func(origin *Vertex[int, float64], edge *Edge[int, float64]) (float64, bool) {
+			examined++
+			return edge.cost, true
+		}
+
+		if path := dijkstra.FindShortestPath(1, 6); path != nil {
+			t.Errorf("Expected nil, got %v", path)
+		}
+		if path, cost := dijkstra.FindShortestPathWithVertexCost(1, 6, func(*Vertex[int, float64]) float64 { return 0 }); path != nil || cost != 0 {
+			t.Errorf("Expected nil, got %v with cost %v", path, cost)
+		}
+		if examined != 0 {
+			t.Errorf("Expected no edges to be examined, got %d", examined)
+		}
+	})
+
+	t.Run("Same component behaves normally", func(t *testing.T) {
+		graph := buildTwoComponents()
+		dijkstra := NewDijkstraWithComponents(graph, FindConnectedComponents(graph))
+
+		expected := []int{1, 2, 3}
+		if path := dijkstra.FindShortestPath(1, 3); !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+		// Weakly connected but unreachable along the edge directions
+		if path := dijkstra.FindShortestPath(3, 1); path != nil {
+			t.Errorf("Expected nil, got %v", path)
+		}
+	})
+
+	t.Run("Reset drops the components", func(t *testing.T) {
+		graph := buildTwoComponents()
+		dijkstra := NewDijkstraWithComponents(graph, FindConnectedComponents(graph))
+
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 6, 1.0, "1-6")
+		dijkstra.Reset(builder.BuildDirected())
+
+		expected := []int{1, 6}
+		if path := dijkstra.FindShortestPath(1, 6); !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+	})
+}
+
+func TestDijkstraFindShortestPathEdges(t *testing.T) {
+	t.Run("Parallel edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "slow")
+		builder.AddEdge(1, 2, 2.0, "fast")
+		builder.AddEdge(2, 3, 1.0, "fast")
+		builder.AddEdge(2, 3, 4.0, "slow")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		edges := dijkstra.FindShortestPathEdges(1, 3)
+		if len(edges) != 2 {
+			t.Fatalf("Expected 2 edges, got %d", len(edges))
+		}
+		expectedCosts := []float64{2.0, 1.0}
+		expectedTargets := []int{2, 3}
+		for i, edge := range edges {
+			if edge.GetCost() != expectedCosts[i] || edge.GetTargetVertex().GetId() != expectedTargets[i] {
+				t.Errorf("Expected edge to %d with cost %v, got edge to %d with cost %v",
+					expectedTargets[i], expectedCosts[i], edge.GetTargetVertex().GetId(), edge.GetCost())
+			}
+			if data, _ := graph.GetEdgeData(edge); *data != "fast" {
+				t.Errorf("Expected edge data fast, got %s", *data)
+			}
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		edges := dijkstra.FindShortestPathEdges(1, 1)
+		if edges == nil || len(edges) != 0 {
+			t.Errorf("Expected an empty slice, got %v", edges)
+		}
+	})
+
+	t.Run("No path", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		if edges := dijkstra.FindShortestPathEdges(2, 1); edges != nil {
+			t.Errorf("Expected nil, got %v", edges)
+		}
+	})
+}
+
+func TestDijkstraIndexedHeap(t *testing.T) {
+	t.Run("Matches Bellman-Ford on random graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(50, 0.1, seed,
+				func(origin int, target int) int { return (origin*31 + target) % 11 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			dijkstra := NewDijkstra(graph)
+			bellmanFord := NewBellmanFord(graph)
+
+			for end := 1; end < 50; end++ {
+				expectedPath := bellmanFord.FindShortestPath(0, end)
+				path := dijkstra.FindShortestPath(0, end)
+				if (path == nil) != (expectedPath == nil) {
+					t.Fatalf("Seed %d, end %d: expected path %v, got %v", seed, end, expectedPath, path)
+				}
+				if path == nil {
+					continue
+				}
+				expectedCost := bellmanFord.vertexData[graph.idToIndex[end]].cost
+				cost := dijkstra.vertexData[graph.idToIndex[end]].cost
+				if cost != expectedCost {
+					t.Errorf("Seed %d, end %d: expected cost %d (path %v), got %d (path %v)",
+						seed, end, expectedCost, expectedPath, cost, path)
+				}
+			}
+		}
+	})
+
+	t.Run("Each vertex is in the heap at most once", func(t *testing.T) {
+		graph := GenerateRandom(100, 0.5, 1,
+			func(origin int, target int) float64 { return float64((origin*31+target)%100 + 1) },
+			func(id int) string { return "vertex" },
+			func(origin int, target int) string { return "edge" },
+		)
+		dijkstra := NewDijkstra(graph)
+
+		maxLen := 0
+		heapLen := func() {
+			if dijkstra.heap.Len() > maxLen {
+				maxLen = dijkstra.heap.Len()
+			}
+		}
+		dijkstra.Amplifier = func(origin *Vertex[int, float64], edge *Edge[int, float64]) (float64, bool) {
+			heapLen()
+			return edge.cost, true
+		}
+		dijkstra.FindShortestPath(0, 99)
+
+		if maxLen > graph.GetVertexCount() {
+			t.Errorf("Expected at most %d heap entries, got %d", graph.GetVertexCount(), maxLen)
+		}
+	})
+
+	t.Run("A search stopped early doesn't affect the next one", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 5.0, "edge1-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(2, 4, 10.0, "edge2-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		// Vertices 3 and 4 are left in the heap when the search stops at vertex 2
+		if path := dijkstra.FindShortestPath(1, 2); !slicesEqual(path, []int{1, 2}) {
+			t.Errorf("Expected path [1 2], got %v", path)
+		}
+		if path := dijkstra.FindShortestPath(1, 4); !slicesEqual(path, []int{1, 3, 4}) {
+			t.Errorf("Expected path [1 3 4], got %v", path)
+		}
+	})
+}
+
+func TestDijkstraFindNearestSource(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 5, 10.0, "edge1-5")
+	builder.AddEdge(10, 11, 2.0, "edge10-11")
+	builder.AddEdge(11, 5, 2.0, "edge11-5")
+	builder.AddVertex(20, "isolated")
+	graph := builder.BuildDirected()
+
+	t.Run("Target is attributed to the nearer source", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+
+		source, path, cost := dijkstra.FindNearestSource([]int{1, 10}, 5)
+		if source != 10 {
+			t.Errorf("Expected source 10, got %d", source)
+		}
+		if !slicesEqual(path, []int{10, 11, 5}) {
+			t.Errorf("Expected path [10 11 5], got %v", path)
+		}
+		if cost != 4.0 {
+			t.Errorf("Expected cost 4, got %v", cost)
+		}
+	})
+
+	t.Run("Target is a source", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+
+		source, path, cost := dijkstra.FindNearestSource([]int{1, 5}, 5)
+		if source != 5 || !slicesEqual(path, []int{5}) || cost != 0 {
+			t.Errorf("Expected source 5 with path [5] and cost 0, got %d with %v and %v", source, path, cost)
+		}
+	})
+
+	t.Run("Unreachable target and non-existent sources", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+
+		source, path, cost := dijkstra.FindNearestSource([]int{1, 99}, 20)
+		if source != 0 || path != nil || cost != 0 {
+			t.Errorf("Expected no source, got %d with %v and %v", source, path, cost)
+		}
+		if _, path, _ := dijkstra.FindNearestSource([]int{99, 1}, 5); !slicesEqual(path, []int{1, 5}) {
+			t.Errorf("Expected path [1 5], got %v", path)
+		}
+	})
+}
+
+func TestDijkstraStrictNonNegative(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 1.0, "1-2")
+	builder.AddEdge(1, 3, 2.0, "1-3")
+	builder.AddEdge(3, 2, -5.0, "3-2")
+	builder.AddEdge(2, 4, 5.0, "2-4")
+	graph := builder.BuildDirected()
+
+	t.Run("Negative edge is rejected", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		dijkstra.StrictNonNegative = true
+
+		path, err := dijkstra.FindShortestPathCtx(context.Background(), 1, 4)
+
+		if !errors.Is(err, ErrNegativeEdgeCost) || path != nil {
+			t.Errorf("Expected ErrNegativeEdgeCost, got %v, %v", path, err)
+		}
+		if path := dijkstra.FindShortestPath(1, 4); path != nil {
+			t.Errorf("Expected nil path, got %v", path)
+		}
+	})
+
+	t.Run("Every search rejects negative edges", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+		dijkstra.StrictNonNegative = true
+
+		if reachable := dijkstra.ReachableWithin(1, 100.0); reachable != nil {
+			t.Errorf("ReachableWithin: expected nil, got %v", reachable)
+		}
+		if source, path, cost := dijkstra.FindNearestSource([]int{1}, 4); source != 0 || path != nil || cost != 0 {
+			t.Errorf("FindNearestSource: expected no result, got %d, %v, %f", source, path, cost)
+		}
+		noToll := func(vertex *Vertex[int, float64]) float64 { return 0 }
+		if path, cost := dijkstra.FindShortestPathWithVertexCost(1, 4, noToll); path != nil || cost != 0 {
+			t.Errorf("FindShortestPathWithVertexCost: expected nil path, got %v with cost %f", path, cost)
+		}
+		if dag := dijkstra.ShortestPathDAG(1); dag != nil {
+			t.Errorf("ShortestPathDAG: expected nil, got %v", dag)
+		}
+		if count := dijkstra.ShortestPathCount(1, 4); count != 0 {
+			t.Errorf("ShortestPathCount: expected 0, got %d", count)
+		}
+		if path, cost := dijkstra.SecondShortestPath(1, 4); path != nil || cost != 0 {
+			t.Errorf("SecondShortestPath: expected nil path, got %v with cost %f", path, cost)
+		}
+		if paths, err := dijkstra.DisjointPaths(1, 4, 1); !errors.Is(err, ErrNegativeEdgeCost) || paths != nil {
+			t.Errorf("DisjointPaths: expected ErrNegativeEdgeCost, got %v, %v", paths, err)
+		}
+		byCost := func(edge *Edge[int, float64]) int { return int(edge.GetCost()) }
+		if path, cost := dijkstra.FindShortestPathAlternating(1, 4, byCost); path != nil || cost != 0 {
+			t.Errorf("FindShortestPathAlternating: expected nil path, got %v with cost %f", path, cost)
+		}
+	})
+
+	t.Run("Negative cost created by the amplifier", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 3, 1.0, "2-3")
+		dijkstra := NewDijkstra(builder.BuildDirected())
+		dijkstra.StrictNonNegative = true
+		dijkstra.Amplifier = func(origin *Vertex[int, float64], edge *Edge[int, float64]) (float64, bool) {
+			return edge.GetCost() - 2, true
+		}
+
+		if _, err := dijkstra.FindShortestPathCtx(context.Background(), 1, 3); !errors.Is(err, ErrNegativeEdgeCost) {
+			t.Errorf("Expected ErrNegativeEdgeCost, got %v", err)
+		}
+	})
+
+	t.Run("Off by default", func(t *testing.T) {
+		dijkstra := NewDijkstra(graph)
+
+		path, err := dijkstra.FindShortestPathCtx(context.Background(), 1, 4)
+
+		// Silently incorrect: 1->3->2->4 costs 2, less than the 6 of the returned path
+		if err != nil || !slicesEqual(path, []int{1, 2, 4}) {
+			t.Errorf("Expected path [1 2 4] and no error, got %v, %v", path, err)
+		}
+	})
+
+	t.Run("Non-negative graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 1, 0.0, "2-1")
+		dijkstra := NewDijkstra(builder.BuildDirected())
+		dijkstra.StrictNonNegative = true
+
+		path, err := dijkstra.FindShortestPathCtx(context.Background(), 1, 2)
+
+		if err != nil || !slicesEqual(path, []int{1, 2}) {
+			t.Errorf("Expected path [1 2] and no error, got %v, %v", path, err)
+		}
+	})
+}
@@ -0,0 +1,179 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+)
+
+// ErrNotEnoughDisjointPaths is returned by DisjointPaths when fewer edge-disjoint
+// paths than requested exist between the vertices.
+var ErrNotEnoughDisjointPaths = errors.New("not enough edge-disjoint paths")
+
+// Finds k edge-disjoint paths between two vertices with the minimum total cost using
+// Suurballe's algorithm, e.g. a primary and a backup route for fault-tolerant routing.
+// Each round runs Dijkstra on the residual graph, where the edges of the paths found so
+// far are reversed with negated costs, so that later paths can reroute the earlier ones.
+// The costs are transformed into non-negative reduced costs with the distances of the
+// previous round, which keeps Dijkstra applicable. Parallel edges are distinct edges,
+// self-loops are ignored. Edge costs must be non-negative.
+// The Amplifier and the snapshot cost overrides are respected as by FindShortestPath.
+// Returns the paths, or ErrNotEnoughDisjointPaths if fewer than k disjoint paths exist,
+// or ErrNegativeEdgeCost if StrictNonNegative is set and an edge cost is negative.
+// Returns an error if either vertex doesn't exist, the vertices are the same or k isn't positive.
+// Time complexity: O(k * E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) DisjointPaths(start I, end I, k int) ([][]I, error) {
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil, err
+	}
+	endVertex, err := d.graph.GetVertexById(end)
+	if err != nil {
+		return nil, err
+	}
+	if start == end {
+		return nil, errors.New("start and end must be different vertices")
+	}
+	if k <= 0 {
+		return nil, errors.New("the number of paths must be positive")
+	}
+
+	// Residual arcs: every edge yields a forward arc at an even index and the reverse
+	// arc right after it, so the reverse of arc a is arc a^1. An arc is usable when
+	// its flow is below its capacity of one (zero for reverse arcs).
+	vertexCount := len(d.graph.vertices)
+	var arcTarget []int
+	var arcCost []C
+	var arcFlow []int
+	vertexArcs := make([][]int, vertexCount)
+	for i := range d.graph.vertices {
+		origin := &d.graph.vertices[i]
+		for j := range origin.edges {
+			edge := &origin.edges[j]
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if targetIdx == i {
+				continue
+			}
+			cost, enabled, err := d.edgeCost(origin, edge)
+			if err != nil {
+				return nil, err
+			}
+			if !enabled {
+				continue
+			}
+			vertexArcs[i] = append(vertexArcs[i], len(arcTarget))
+			arcTarget = append(arcTarget, targetIdx)
+			arcCost = append(arcCost, cost)
+			vertexArcs[targetIdx] = append(vertexArcs[targetIdx], len(arcTarget))
+			arcTarget = append(arcTarget, i)
+			arcCost = append(arcCost, -cost)
+		}
+	}
+	arcFlow = make([]int, len(arcTarget))
+	arcCapacity := func(arc int) int { return 1 - arc%2 }
+
+	startIdx := startVertex.GetCustomDataIndex()
+	endIdx := endVertex.GetCustomDataIndex()
+	potential := make([]C, vertexCount)
+	distance := make([]C, vertexCount)
+	reached := make([]bool, vertexCount)
+	visited := make([]bool, vertexCount)
+	parentArc := make([]int, vertexCount)
+	pq := &costHeap[C]{}
+
+	for round := 0; round < k; round++ {
+		for i := 0; i < vertexCount; i++ {
+			reached[i] = false
+			visited[i] = false
+			parentArc[i] = -1
+		}
+		*pq = (*pq)[:0]
+		reached[startIdx] = true
+		distance[startIdx] = 0
+		heap.Push(pq, costHeapItem[C]{cost: 0, vertexIdx: startIdx})
+
+		for pq.Len() > 0 {
+			item := heap.Pop(pq).(costHeapItem[C])
+			currentIdx := item.vertexIdx
+			if visited[currentIdx] {
+				continue
+			}
+			visited[currentIdx] = true
+
+			for _, arc := range vertexArcs[currentIdx] {
+				targetIdx := arcTarget[arc]
+				if visited[targetIdx] || arcFlow[arc] >= arcCapacity(arc) {
+					continue
+				}
+				// The reduced cost is non-negative as long as the potentials are the
+				// distances of the previous round
+				reducedCost := arcCost[arc] + potential[currentIdx] - potential[targetIdx]
+				tentativeDistance := distance[currentIdx] + reducedCost
+				if !reached[targetIdx] || tentativeDistance < distance[targetIdx] {
+					reached[targetIdx] = true
+					distance[targetIdx] = tentativeDistance
+					parentArc[targetIdx] = arc
+					heap.Push(pq, costHeapItem[C]{cost: tentativeDistance, vertexIdx: targetIdx})
+				}
+			}
+		}
+
+		if !reached[endIdx] {
+			return nil, ErrNotEnoughDisjointPaths
+		}
+
+		// Augment along the path, which cancels the flow on the reversed arcs it uses
+		for idx := endIdx; idx != startIdx; idx = arcTarget[parentArc[idx]^1] {
+			arc := parentArc[idx]
+			arcFlow[arc]++
+			arcFlow[arc^1]--
+		}
+
+		// The vertices that weren't reached stay unreachable, since augmenting adds
+		// arcs only between reached vertices, so their potentials don't matter
+		for i := 0; i < vertexCount; i++ {
+			if reached[i] {
+				potential[i] += distance[i]
+			}
+		}
+	}
+
+	// Decompose the flow into paths by following the forward arcs carrying it
+	paths := make([][]I, 0, k)
+	onPath := make([]bool, vertexCount)
+	for len(paths) < k {
+		walk := []int{startIdx}
+		onPath[startIdx] = true
+		for idx := startIdx; idx != endIdx; {
+			next := -1
+			for _, arc := range vertexArcs[idx] {
+				if arc%2 == 0 && arcFlow[arc] > 0 {
+					arcFlow[arc] = 0
+					next = arcTarget[arc]
+					break
+				}
+			}
+			// Drop the zero-cost cycles the flow may contain
+			if onPath[next] {
+				for walk[len(walk)-1] != next {
+					onPath[walk[len(walk)-1]] = false
+					walk = walk[:len(walk)-1]
+				}
+			} else {
+				onPath[next] = true
+				walk = append(walk, next)
+			}
+			idx = next
+		}
+
+		path := make([]I, len(walk))
+		for i, idx := range walk {
+			path[i] = d.graph.vertices[idx].id
+			onPath[idx] = false
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDisjointPaths(t *testing.T) {
+	// The shortest path 1->2->3->4 (cost 3) blocks any second disjoint path,
+	// so Suurballe's algorithm has to reroute it into 1->2->4 and 1->3->4.
+	buildTrapGraph := func() *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 3, 1.0, "2-3")
+		builder.AddEdge(3, 4, 1.0, "3-4")
+		builder.AddEdge(1, 3, 3.0, "1-3")
+		builder.AddEdge(2, 4, 3.0, "2-4")
+		return builder.BuildDirected()
+	}
+
+	pathCost := func(graph *Graph[int, float64, string, string], path []int) float64 {
+		total := 0.0
+		for i := 0; i+1 < len(path); i++ {
+			vertex, _ := graph.GetVertexById(path[i])
+			for _, edge := range vertex.GetEdges() {
+				if edge.GetTargetVertex().GetId() == path[i+1] {
+					total += edge.GetCost()
+					break
+				}
+			}
+		}
+		return total
+	}
+
+	t.Run("Two edge-disjoint routes", func(t *testing.T) {
+		graph := buildTrapGraph()
+		dijkstra := NewDijkstra(graph)
+
+		paths, err := dijkstra.DisjointPaths(1, 4, 2)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(paths) != 2 {
+			t.Fatalf("Expected 2 paths, got %v", paths)
+		}
+
+		used := make(map[EdgeKey[int]]bool)
+		total := 0.0
+		for _, path := range paths {
+			if path[0] != 1 || path[len(path)-1] != 4 {
+				t.Errorf("Expected path from 1 to 4, got %v", path)
+			}
+			for i := 0; i+1 < len(path); i++ {
+				key := EdgeKey[int]{Origin: path[i], Target: path[i+1]}
+				if used[key] {
+					t.Errorf("Expected edge-disjoint paths, edge %d->%d is shared in %v", path[i], path[i+1], paths)
+				}
+				used[key] = true
+			}
+			total += pathCost(graph, path)
+		}
+		if total != 8.0 {
+			t.Errorf("Expected total cost 8, got %v", total)
+		}
+	})
+
+	t.Run("Single path", func(t *testing.T) {
+		graph := buildTrapGraph()
+		dijkstra := NewDijkstra(graph)
+
+		paths, err := dijkstra.DisjointPaths(1, 4, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		expected := []int{1, 2, 3, 4}
+		if len(paths) != 1 || !slicesEqual(paths[0], expected) {
+			t.Errorf("Expected [%v], got %v", expected, paths)
+		}
+	})
+
+	t.Run("Fewer disjoint paths than requested", func(t *testing.T) {
+		graph := buildTrapGraph()
+		dijkstra := NewDijkstra(graph)
+
+		paths, err := dijkstra.DisjointPaths(1, 4, 3)
+		if !errors.Is(err, ErrNotEnoughDisjointPaths) {
+			t.Errorf("Expected ErrNotEnoughDisjointPaths, got %v", err)
+		}
+		if paths != nil {
+			t.Errorf("Expected nil paths, got %v", paths)
+		}
+	})
+
+	t.Run("Parallel edges are disjoint", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "first")
+		builder.AddEdge(1, 2, 5.0, "second")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		paths, err := dijkstra.DisjointPaths(1, 2, 2)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(paths) != 2 {
+			t.Errorf("Expected 2 paths, got %v", paths)
+		}
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		graph := buildTrapGraph()
+		dijkstra := NewDijkstra(graph)
+
+		if _, err := dijkstra.DisjointPaths(1, 99, 2); err == nil {
+			t.Errorf("Expected error for non-existent vertex")
+		}
+		if _, err := dijkstra.DisjointPaths(1, 1, 2); err == nil {
+			t.Errorf("Expected error for the same vertices")
+		}
+		if _, err := dijkstra.DisjointPaths(1, 4, 0); err == nil {
+			t.Errorf("Expected error for non-positive k")
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package graph
+
+// MinDominatingSetApprox finds a small dominating set, i.e. a set of vertices such that
+// every vertex is either in the set or adjacent to a member of it.
+// The graph is treated as undirected. Finding the minimum dominating set is NP-hard,
+// so the greedy set cover heuristic is used: the vertex covering the most still
+// uncovered vertices (including itself) is picked repeatedly until everything is covered.
+// Ties are broken in favor of the vertex added to the graph first.
+// The result is within a factor of ln(maxDegree + 1) + 1 of the optimum.
+// Time complexity: O(V * (V + E)) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func MinDominatingSetApprox[I Id, C Cost, V any, E any](g *Graph[I, C, V, E]) []I {
+	adjacency := g.undirectedAdjacency()
+	covered := make([]bool, len(g.vertices))
+	uncoveredCount := len(g.vertices)
+	var dominatingSet []I
+
+	for uncoveredCount > 0 {
+		bestIdx, bestGain := -1, 0
+		for i := range g.vertices {
+			gain := 0
+			if !covered[i] {
+				gain++
+			}
+			for _, neighborIdx := range adjacency[i] {
+				if neighborIdx != i && !covered[neighborIdx] {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				bestIdx, bestGain = i, gain
+			}
+		}
+
+		dominatingSet = append(dominatingSet, g.vertices[bestIdx].id)
+		if !covered[bestIdx] {
+			covered[bestIdx] = true
+			uncoveredCount--
+		}
+		for _, neighborIdx := range adjacency[bestIdx] {
+			if !covered[neighborIdx] {
+				covered[neighborIdx] = true
+				uncoveredCount--
+			}
+		}
+	}
+
+	return dominatingSet
+}
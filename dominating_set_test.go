@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"testing"
+)
+
+// isDominatingSet checks that every vertex is in the set or adjacent to a member of it.
+func isDominatingSet(graph *Graph[int, float64, string, string], set []int) bool {
+	dominated := make(map[int]bool)
+	for _, id := range set {
+		dominated[id] = true
+	}
+	graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+		for _, id := range set {
+			if vertex.GetId() == id {
+				dominated[edge.GetTargetVertex().GetId()] = true
+			}
+			if edge.GetTargetVertex().GetId() == id {
+				dominated[vertex.GetId()] = true
+			}
+		}
+	})
+	return len(dominated) == graph.GetVertexCount()
+}
+
+func TestMinDominatingSetApprox(t *testing.T) {
+	t.Run("Star graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(1, 4, 1.0, "edge1-4")
+		builder.AddEdge(5, 1, 1.0, "edge5-1")
+		builder.AddEdge(1, 6, 1.0, "edge1-6")
+
+		graph := builder.BuildDirected()
+		set := MinDominatingSetApprox(graph)
+
+		if len(set) != 1 || set[0] != 1 {
+			t.Errorf("Expected dominating set [1], got %v", set)
+		}
+	})
+
+	t.Run("Path graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i < 7; i++ {
+			builder.AddBiEdge(i, i+1, 1.0, "edge")
+		}
+
+		graph := builder.BuildDirected()
+		set := MinDominatingSetApprox(graph)
+
+		if !isDominatingSet(graph, set) {
+			t.Errorf("Expected a valid dominating set, got %v", set)
+		}
+		if len(set) > 4 {
+			t.Errorf("Expected at most 4 vertices, got %v", set)
+		}
+	})
+
+	t.Run("Isolated vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+		builder.AddVertex(2, "vertex2")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		set := MinDominatingSetApprox(graph)
+
+		if len(set) != 3 || !isDominatingSet(graph, set) {
+			t.Errorf("Expected a valid dominating set of 3 vertices, got %v", set)
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if set := MinDominatingSetApprox(graph); len(set) != 0 {
+			t.Errorf("Expected empty set, got %v", set)
+		}
+	})
+}
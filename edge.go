@@ -42,3 +42,12 @@ func (e *Edge[I, C]) Clone() Edge[I, C] {
 		customDataIndex: e.customDataIndex,
 	}
 }
+
+// EdgeKey identifies an edge by the IDs of its endpoints.
+// It can be used as a map key for per-edge results of the algorithms.
+// Algorithms that treat the graph as undirected normalize the key so that
+// Origin <= Target, which is documented by each of them.
+type EdgeKey[I Id] struct {
+	Origin I // Origin vertex identifier
+	Target I // Target vertex identifier
+}
@@ -0,0 +1,169 @@
+package graph
+
+// EdgeChromaticIndex computes a proper edge coloring of the undirected interpretation
+// of the graph, in which no two edges sharing a vertex get the same color, e.g. to
+// schedule conflicting tasks on the edges.
+// It uses the Misra & Gries constructive proof of Vizing's theorem, so at most
+// maxDegree+1 colors are used. Since the chromatic index is either maxDegree or
+// maxDegree+1 (and deciding which is NP-hard), the returned number of colors is the
+// exact chromatic index or exceeds it by one.
+// Edges connecting the same pair of vertices in either direction are one undirected
+// edge, and self-loops are ignored. The coloring maps the undirected edges to colors
+// from 0 to the number of colors minus one, under both orientations of EdgeKey.
+// Time complexity: O(V * E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V * D + E) where D is the maximum degree and E is the number of edges.
+func EdgeChromaticIndex[I Id, C Cost, V any, E any](g *Graph[I, C, V, E]) (int, map[EdgeKey[I]]int) {
+	adjacency := g.undirectedAdjacency()
+	maxDegree := 0
+	for i := range adjacency {
+		degree := 0
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				degree++
+			}
+		}
+		if degree > maxDegree {
+			maxDegree = degree
+		}
+	}
+
+	colorCount := maxDegree + 1
+	// at[u][c] is the neighbor connected to u with the edge of the color c, or -1
+	at := make([][]int, len(g.vertices))
+	for i := range at {
+		at[i] = make([]int, colorCount)
+		for c := range at[i] {
+			at[i][c] = -1
+		}
+	}
+	colorOf := func(u int, v int) int {
+		for c, w := range at[u] {
+			if w == v {
+				return c
+			}
+		}
+		return -1
+	}
+	freeColor := func(u int) int {
+		for c, w := range at[u] {
+			if w < 0 {
+				return c
+			}
+		}
+		return -1
+	}
+	setColor := func(u int, v int, c int) {
+		at[u][c] = v
+		at[v][c] = u
+	}
+	clearColor := func(u int, v int, c int) {
+		at[u][c] = -1
+		at[v][c] = -1
+	}
+
+	inFan := make([]bool, len(g.vertices))
+	var fan []int
+	var pathEdges [][2]int
+	for u := range adjacency {
+		for _, v := range adjacency[u] {
+			if v <= u {
+				continue // Each undirected edge once, without self-loops
+			}
+
+			// Build a maximal fan of u starting with v
+			fan = append(fan[:0], v)
+			inFan[v] = true
+			for extended := true; extended; {
+				extended = false
+				last := fan[len(fan)-1]
+				for c := 0; c < colorCount; c++ {
+					if w := at[u][c]; w >= 0 && !inFan[w] && at[last][c] < 0 {
+						fan = append(fan, w)
+						inFan[w] = true
+						extended = true
+						break
+					}
+				}
+			}
+
+			c := freeColor(u)
+			d := freeColor(fan[len(fan)-1])
+
+			// Invert the cd-path starting at u, which makes d free on u
+			pathEdges = pathEdges[:0]
+			for x, color := u, d; at[x][color] >= 0; {
+				y := at[x][color]
+				pathEdges = append(pathEdges, [2]int{x, y})
+				x = y
+				if color == d {
+					color = c
+				} else {
+					color = d
+				}
+			}
+			for k, edge := range pathEdges {
+				if k%2 == 0 {
+					clearColor(edge[0], edge[1], d)
+				} else {
+					clearColor(edge[0], edge[1], c)
+				}
+			}
+			for k, edge := range pathEdges {
+				if k%2 == 0 {
+					setColor(edge[0], edge[1], c)
+				} else {
+					setColor(edge[0], edge[1], d)
+				}
+			}
+
+			// Find the longest prefix of the fan that is still a fan and ends with
+			// a vertex on which d is free
+			w := 0
+			for i := range fan {
+				if i > 0 && at[fan[i-1]][colorOf(u, fan[i])] >= 0 {
+					break
+				}
+				if at[fan[i]][d] < 0 {
+					w = i
+					break
+				}
+			}
+
+			// Rotate the fan prefix and color its last edge with d
+			for i := 1; i <= w; i++ {
+				color := colorOf(u, fan[i])
+				clearColor(u, fan[i], color)
+				setColor(u, fan[i-1], color)
+			}
+			setColor(u, fan[w], d)
+
+			for _, f := range fan {
+				inFan[f] = false
+			}
+		}
+	}
+
+	// Renumber the used colors densely
+	colorIds := make([]int, colorCount)
+	for i := range colorIds {
+		colorIds[i] = -1
+	}
+	usedColors := 0
+	coloring := make(map[EdgeKey[I]]int)
+	for u := range at {
+		for c, v := range at[u] {
+			if v < 0 || v < u {
+				continue
+			}
+			if colorIds[c] < 0 {
+				colorIds[c] = usedColors
+				usedColors++
+			}
+			uId, vId := g.vertices[u].id, g.vertices[v].id
+			coloring[EdgeKey[I]{Origin: uId, Target: vId}] = colorIds[c]
+			coloring[EdgeKey[I]{Origin: vId, Target: uId}] = colorIds[c]
+		}
+	}
+
+	return usedColors, coloring
+}
@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"testing"
+)
+
+// checkEdgeColoring verifies that the coloring is proper and covers every undirected edge.
+func checkEdgeColoring(t *testing.T, graph *Graph[int, float64, string, string], colorCount int, coloring map[EdgeKey[int]]int) {
+	t.Helper()
+	seen := make(map[int]map[int]int) // Vertex -> color -> neighbor
+	graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+		u, v := vertex.GetId(), edge.GetTargetVertex().GetId()
+		if u == v {
+			return
+		}
+		color, ok := coloring[EdgeKey[int]{Origin: u, Target: v}]
+		if !ok {
+			t.Errorf("Edge %d-%d isn't colored", u, v)
+			return
+		}
+		if color < 0 || color >= colorCount {
+			t.Errorf("Color %d of edge %d-%d is out of range [0, %d)", color, u, v, colorCount)
+		}
+		for _, pair := range [2][2]int{{u, v}, {v, u}} {
+			if seen[pair[0]] == nil {
+				seen[pair[0]] = make(map[int]int)
+			}
+			if other, exists := seen[pair[0]][color]; exists && other != pair[1] {
+				t.Errorf("Edges %d-%d and %d-%d share vertex %d and color %d", pair[0], pair[1], pair[0], other, pair[0], color)
+			}
+			seen[pair[0]][color] = pair[1]
+		}
+	})
+}
+
+func TestEdgeChromaticIndex(t *testing.T) {
+	t.Run("Star graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for leaf := 1; leaf <= 5; leaf++ {
+			builder.AddBiEdge(0, leaf, 1.0, "spoke")
+		}
+
+		graph := builder.BuildDirected()
+		colorCount, coloring := EdgeChromaticIndex(graph)
+
+		if colorCount != 5 {
+			t.Errorf("Expected chromatic index 5, got %d", colorCount)
+		}
+		checkEdgeColoring(t, graph, colorCount, coloring)
+	})
+
+	t.Run("Triangle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		colorCount, coloring := EdgeChromaticIndex(graph)
+
+		if colorCount != 3 {
+			t.Errorf("Expected chromatic index 3, got %d", colorCount)
+		}
+		checkEdgeColoring(t, graph, colorCount, coloring)
+	})
+
+	t.Run("Random graphs stay within max degree plus one", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(25, 0.3, seed,
+				func(origin int, target int) float64 { return 1.0 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			colorCount, coloring := EdgeChromaticIndex(graph)
+
+			maxDegree := 0
+			for _, adjacency := range graph.undirectedAdjacency() {
+				if len(adjacency) > maxDegree {
+					maxDegree = len(adjacency)
+				}
+			}
+			if colorCount < maxDegree || colorCount > maxDegree+1 {
+				t.Errorf("Seed %d: expected %d or %d colors, got %d", seed, maxDegree, maxDegree+1, colorCount)
+			}
+			checkEdgeColoring(t, graph, colorCount, coloring)
+		}
+	})
+
+	t.Run("Graph without edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+
+		graph := builder.BuildDirected()
+		colorCount, coloring := EdgeChromaticIndex(graph)
+
+		if colorCount != 0 || len(coloring) != 0 {
+			t.Errorf("Expected no colors, got %d and %v", colorCount, coloring)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package graph
+
+// AllElementaryCycles enumerates every elementary (simple) directed cycle of the graph
+// exactly once using Johnson's algorithm, which is needed e.g. for a thorough deadlock
+// analysis, while FindCycles reports at most one cycle per group of vertices.
+// Each cycle starts at its vertex that was added to the graph first, and a self-loop is
+// a cycle of a single vertex. Parallel edges don't produce distinct cycles.
+// NOTE: The number of elementary cycles may grow exponentially with the number of
+// vertices (e.g. in a complete graph), so both the result and the running time are
+// exponential in the worst case.
+// Time complexity: O((V + E) * (V + C)) where V is the number of vertices, E is the number
+// of edges and C is the number of cycles.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges,
+// not counting the result.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *DFS[I, C, V, E]) AllElementaryCycles() [][]I {
+	vertexCount := len(d.graph.vertices)
+	adjacency := make([][]int, vertexCount)
+	reverseAdjacency := make([][]int, vertexCount)
+	seen := make([]int, vertexCount)
+	for i := range seen {
+		seen[i] = -1
+	}
+	for i := range d.graph.vertices {
+		for _, edge := range d.graph.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if seen[targetIdx] == i {
+				continue // Parallel edge
+			}
+			seen[targetIdx] = i
+			adjacency[i] = append(adjacency[i], targetIdx)
+			reverseAdjacency[targetIdx] = append(reverseAdjacency[targetIdx], i)
+		}
+	}
+
+	var cycles [][]I
+	inComponent := make([]bool, vertexCount)
+	forward := make([]bool, vertexCount)
+	blocked := make([]bool, vertexCount)
+	blockedBy := make([][]int, vertexCount)
+	var queue, unblockStack []int
+
+	type stackItem struct {
+		vertexIdx int
+		edgeIdx   int  // Index of the next outgoing edge to process
+		found     bool // Whether a cycle has been closed through the vertex
+	}
+	var stack []stackItem
+	var path []int
+
+	for start := 0; start < vertexCount; start++ {
+		// Restrict the search to the strongly connected component of the start vertex
+		// in the subgraph induced by it and the vertices that were added after it,
+		// since the cycles through the earlier vertices have already been reported
+		for i := 0; i < vertexCount; i++ {
+			forward[i] = false
+			inComponent[i] = false
+			blocked[i] = false
+			blockedBy[i] = blockedBy[i][:0]
+		}
+		forward[start] = true
+		queue = append(queue[:0], start)
+		for head := 0; head < len(queue); head++ {
+			for _, neighborIdx := range adjacency[queue[head]] {
+				if neighborIdx >= start && !forward[neighborIdx] {
+					forward[neighborIdx] = true
+					queue = append(queue, neighborIdx)
+				}
+			}
+		}
+		inComponent[start] = true
+		queue = append(queue[:0], start)
+		for head := 0; head < len(queue); head++ {
+			for _, neighborIdx := range reverseAdjacency[queue[head]] {
+				if neighborIdx >= start && forward[neighborIdx] && !inComponent[neighborIdx] {
+					inComponent[neighborIdx] = true
+					queue = append(queue, neighborIdx)
+				}
+			}
+		}
+
+		// Walk the simple paths from the start vertex, blocking the vertices that can't
+		// currently lead back to it until a cycle is closed through them
+		blocked[start] = true
+		path = append(path[:0], start)
+		stack = append(stack[:0], stackItem{vertexIdx: start})
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			edges := adjacency[top.vertexIdx]
+
+			if top.edgeIdx < len(edges) {
+				neighborIdx := edges[top.edgeIdx]
+				top.edgeIdx++
+				if !inComponent[neighborIdx] {
+					continue
+				}
+				if neighborIdx == start {
+					cycle := make([]I, len(path))
+					for i, idx := range path {
+						cycle[i] = d.graph.vertices[idx].id
+					}
+					cycles = append(cycles, cycle)
+					top.found = true
+				} else if !blocked[neighborIdx] {
+					blocked[neighborIdx] = true
+					path = append(path, neighborIdx)
+					stack = append(stack, stackItem{vertexIdx: neighborIdx})
+				}
+				continue
+			}
+
+			// All the edges are processed, backtrack
+			item := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			path = path[:len(path)-1]
+			if item.found {
+				// Unblock the vertex and, transitively, the vertices waiting for it
+				unblockStack = append(unblockStack[:0], item.vertexIdx)
+				for len(unblockStack) > 0 {
+					idx := unblockStack[len(unblockStack)-1]
+					unblockStack = unblockStack[:len(unblockStack)-1]
+					if !blocked[idx] {
+						continue
+					}
+					blocked[idx] = false
+					unblockStack = append(unblockStack, blockedBy[idx]...)
+					blockedBy[idx] = blockedBy[idx][:0]
+				}
+				if len(stack) > 0 {
+					stack[len(stack)-1].found = true
+				}
+			} else {
+				// The vertex stays blocked until one of its neighbors gets unblocked
+				for _, neighborIdx := range edges {
+					if inComponent[neighborIdx] && !containsIndex(blockedBy[neighborIdx], item.vertexIdx) {
+						blockedBy[neighborIdx] = append(blockedBy[neighborIdx], item.vertexIdx)
+					}
+				}
+			}
+		}
+	}
+
+	return cycles
+}
+
+// containsIndex reports whether the slice contains the given index.
+func containsIndex(indexes []int, index int) bool {
+	for _, i := range indexes {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
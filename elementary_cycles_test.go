@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+// countCyclesBruteForce counts the elementary cycles by extending every simple path
+// from each vertex through the vertices with greater indexes only.
+func countCyclesBruteForce[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) int {
+	count := 0
+	onPath := make([]bool, len(graph.vertices))
+	var extend func(start int, current int)
+	extend = func(start int, current int) {
+		seen := make(map[int]bool)
+		for _, edge := range graph.vertices[current].edges {
+			next := edge.targetVertex.GetCustomDataIndex()
+			if seen[next] {
+				continue
+			}
+			seen[next] = true
+			if next == start {
+				count++
+			} else if next > start && !onPath[next] {
+				onPath[next] = true
+				extend(start, next)
+				onPath[next] = false
+			}
+		}
+	}
+	for start := range graph.vertices {
+		onPath[start] = true
+		extend(start, start)
+		onPath[start] = false
+	}
+	return count
+}
+
+func TestDFSAllElementaryCycles(t *testing.T) {
+	t.Run("Overlapping cycles sharing an edge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		cycles := dfs.AllElementaryCycles()
+		if len(cycles) != 2 {
+			t.Fatalf("Expected 2 cycles, got %v", cycles)
+		}
+		found := map[string]bool{}
+		for _, cycle := range cycles {
+			found[fmt.Sprint(cycle)] = true
+		}
+		if !found["[1 2 3]"] || !found["[1 2 4]"] {
+			t.Errorf("Expected cycles [1 2 3] and [1 2 4], got %v", cycles)
+		}
+	})
+
+	t.Run("Self-loop and parallel edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 1, 1.0, "edge1-1")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 2, 2.0, "edge1-2-parallel")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		cycles := dfs.AllElementaryCycles()
+		if len(cycles) != 2 {
+			t.Fatalf("Expected 2 cycles, got %v", cycles)
+		}
+		found := map[string]bool{}
+		for _, cycle := range cycles {
+			found[fmt.Sprint(cycle)] = true
+		}
+		if !found["[1]"] || !found["[1 2]"] {
+			t.Errorf("Expected cycles [1] and [1 2], got %v", cycles)
+		}
+	})
+
+	t.Run("Complete graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i <= 4; i++ {
+			for j := 1; j <= 4; j++ {
+				if i != j {
+					builder.AddEdge(i, j, 1.0, "edge")
+				}
+			}
+		}
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		// 6 cycles of length 2, 8 of length 3 and 6 of length 4
+		if cycles := dfs.AllElementaryCycles(); len(cycles) != 20 {
+			t.Errorf("Expected 20 cycles, got %d", len(cycles))
+		}
+	})
+
+	t.Run("Acyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+		dfs := NewDFS(graph)
+
+		if cycles := dfs.AllElementaryCycles(); len(cycles) != 0 {
+			t.Errorf("Expected no cycles, got %v", cycles)
+		}
+	})
+
+	t.Run("Random graphs match brute force", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(8, 0.3, seed,
+				func(origin int, target int) float64 { return 1.0 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			dfs := NewDFS(graph)
+
+			cycles := dfs.AllElementaryCycles()
+			unique := map[string]bool{}
+			for _, cycle := range cycles {
+				unique[fmt.Sprint(cycle)] = true
+			}
+			expected := countCyclesBruteForce(graph)
+			if len(cycles) != expected || len(unique) != expected {
+				t.Errorf("Seed %d: expected %d distinct cycles, got %d (%d distinct)",
+					seed, expected, len(cycles), len(unique))
+			}
+		}
+	})
+}
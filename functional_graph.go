@@ -0,0 +1,74 @@
+package graph
+
+// IsFunctional checks whether the graph is a functional graph, i.e. whether
+// every vertex has exactly one outgoing edge.
+// Returns true for functional graphs (including the empty graph), false otherwise.
+// Time complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) IsFunctional() bool {
+	for i := range g.vertices {
+		if len(g.vertices[i].edges) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// FunctionalGraphCycles finds all cycles of a functional graph.
+// Every weakly connected component of a functional graph is "rho-shaped": it
+// contains exactly one cycle with trees hanging off it, so the cycles can be found
+// by simply following the single outgoing edge of each vertex.
+// Returns a slice of cycles, where each cycle is represented as a slice of vertex IDs.
+// Returns nil if the graph is not functional (see IsFunctional).
+// Time complexity: O(V) where V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) FunctionalGraphCycles() [][]I {
+	if !g.IsFunctional() {
+		return nil
+	}
+
+	// Vertex states: 0 - unvisited, 1 - on the current walk, 2 - done
+	const (
+		unvisited = iota
+		onWalk
+		done
+	)
+	state := make([]uint8, len(g.vertices))
+	var cycles [][]I
+	var walk []int
+
+	for i := range g.vertices {
+		if state[i] != unvisited {
+			continue
+		}
+
+		// Follow successors until a vertex that was already seen is reached
+		walk = walk[:0]
+		idx := i
+		for state[idx] == unvisited {
+			state[idx] = onWalk
+			walk = append(walk, idx)
+			idx = g.vertices[idx].edges[0].targetVertex.GetCustomDataIndex()
+		}
+
+		// Reaching a vertex of the current walk closes a new cycle
+		if state[idx] == onWalk {
+			var cycle []I
+			for k := len(walk) - 1; k >= 0; k-- {
+				if walk[k] == idx {
+					cycle = make([]I, len(walk)-k)
+					for m := range cycle {
+						cycle[m] = g.vertices[walk[k+m]].id
+					}
+					break
+				}
+			}
+			cycles = append(cycles, cycle)
+		}
+
+		for _, walked := range walk {
+			state[walked] = done
+		}
+	}
+
+	return cycles
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestIsFunctional(t *testing.T) {
+	t.Run("Every vertex has one outgoing edge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+
+		if !graph.IsFunctional() {
+			t.Error("Expected graph to be functional")
+		}
+	})
+
+	t.Run("Vertex without outgoing edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+
+		if graph.IsFunctional() {
+			t.Error("Expected graph with a sink not to be functional")
+		}
+	})
+
+	t.Run("Vertex with two outgoing edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 1, 1.0, "edge1-1")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+
+		graph := builder.BuildDirected()
+
+		if graph.IsFunctional() {
+			t.Error("Expected graph with a branching vertex not to be functional")
+		}
+	})
+}
+
+func TestFunctionalGraphCycles(t *testing.T) {
+	t.Run("Rho-shaped components", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Component 1: tail 5 -> 4 -> 1 leading into cycle 1 -> 2 -> 3 -> 1
+		builder.AddEdge(5, 4, 1.0, "edge5-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		// Component 2: self-loop 6 with tail 7 -> 6
+		builder.AddEdge(7, 6, 1.0, "edge7-6")
+		builder.AddEdge(6, 6, 1.0, "edge6-6")
+
+		graph := builder.BuildDirected()
+		cycles := graph.FunctionalGraphCycles()
+
+		if len(cycles) != 2 {
+			t.Fatalf("Expected 2 cycles, got %d: %v", len(cycles), cycles)
+		}
+
+		sizes := map[int]bool{}
+		for _, cycle := range cycles {
+			sizes[len(cycle)] = true
+			for _, id := range cycle {
+				if id == 4 || id == 5 || id == 7 {
+					t.Errorf("Tail vertex %d must not be part of a cycle: %v", id, cycle)
+				}
+			}
+		}
+		if !sizes[3] || !sizes[1] {
+			t.Errorf("Expected cycles of sizes 3 and 1, got %v", cycles)
+		}
+	})
+
+	t.Run("Non-functional graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+
+		if cycles := graph.FunctionalGraphCycles(); cycles != nil {
+			t.Errorf("Expected nil for non-functional graph, got %v", cycles)
+		}
+	})
+}
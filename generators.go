@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+)
+
+// GenerateRandom generates a random directed graph using the Erdős–Rényi G(n, p) model.
+// The vertices are numbered from 0 to n-1 and every possible directed edge between two
+// distinct vertices is included independently with the probability p.
+// The callbacks produce the cost and the custom data of the edges and vertices.
+// The result is deterministic for the same seed and parameters.
+// Time complexity: O(V^2) where V is the number of vertices.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func GenerateRandom[I SInt | UInt, C Cost, V any, E any](
+	n int,
+	p float64,
+	seed int64,
+	costFn func(origin I, target I) C,
+	vdataFn func(id I) V,
+	edataFn func(origin I, target I) E,
+) *Graph[I, C, V, E] {
+	rng := rand.New(rand.NewSource(seed))
+	builder := &Builder[I, C, V, E]{}
+
+	for i := 0; i < n; i++ {
+		builder.AddVertex(I(i), vdataFn(I(i)))
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j || rng.Float64() >= p {
+				continue
+			}
+			origin, target := I(i), I(j)
+			builder.AddEdge(origin, target, costFn(origin, target), edataFn(origin, target))
+		}
+	}
+
+	return builder.BuildDirected()
+}
+
+// GenerateGrid generates a rows x cols grid graph, which is handy for testing pathfinding.
+// The vertices are numbered row-major from 0, so the vertex in the row r and the
+// column c has the id r*cols + c. Orthogonal neighbors are connected with bidirectional
+// edges of the unit cost. If diagonals is true, diagonal neighbors are connected as well
+// with the cost of math.Sqrt2, so the grid distances stay geometrically consistent.
+// The vertices and edges get zero values of the custom data types.
+// Time complexity: O(rows * cols).
+// Space complexity: O(rows * cols).
+func GenerateGrid[V any, E any](rows int, cols int, diagonals bool) *Graph[int, float64, V, E] {
+	builder := &Builder[int, float64, V, E]{}
+	var vertexData V
+	var edgeData E
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			id := r*cols + c
+			builder.AddVertex(id, vertexData)
+			if c+1 < cols {
+				builder.AddBiEdge(id, id+1, 1, edgeData)
+			}
+			if r+1 < rows {
+				builder.AddBiEdge(id, id+cols, 1, edgeData)
+			}
+			if diagonals && r+1 < rows {
+				if c+1 < cols {
+					builder.AddBiEdge(id, id+cols+1, math.Sqrt2, edgeData)
+				}
+				if c > 0 {
+					builder.AddBiEdge(id, id+cols-1, math.Sqrt2, edgeData)
+				}
+			}
+		}
+	}
+
+	return builder.BuildDirected()
+}
+
+// GridManhattanHeuristic creates an A* heuristic for the graphs generated by GenerateGrid
+// with the given number of columns. It estimates the cost as the Manhattan distance,
+// which is admissible only for grids without diagonals.
+func GridManhattanHeuristic[V any, E any](cols int) HeuristicFunc[int, float64, V, E] {
+	return func(origin *Vertex[int, float64], goal *Vertex[int, float64]) float64 {
+		dr := math.Abs(float64(origin.id/cols - goal.id/cols))
+		dc := math.Abs(float64(origin.id%cols - goal.id%cols))
+		return dr + dc
+	}
+}
+
+// GridEuclideanHeuristic creates an A* heuristic for the graphs generated by GenerateGrid
+// with the given number of columns. It estimates the cost as the Euclidean distance,
+// which is admissible both with and without diagonals.
+func GridEuclideanHeuristic[V any, E any](cols int) HeuristicFunc[int, float64, V, E] {
+	return func(origin *Vertex[int, float64], goal *Vertex[int, float64]) float64 {
+		dr := float64(origin.id/cols - goal.id/cols)
+		dc := float64(origin.id%cols - goal.id%cols)
+		return math.Sqrt(dr*dr + dc*dc)
+	}
+}
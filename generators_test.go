@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGenerateRandom(t *testing.T) {
+	unitCost := func(origin int, target int) float64 { return 1.0 }
+	vertexData := func(id int) string { return "vertex" }
+	edgeData := func(origin int, target int) string { return "edge" }
+
+	t.Run("Vertex count", func(t *testing.T) {
+		graph := GenerateRandom(50, 0.1, 1, unitCost, vertexData, edgeData)
+
+		if graph.GetVertexCount() != 50 {
+			t.Errorf("Expected 50 vertices, got %d", graph.GetVertexCount())
+		}
+		for i := 0; i < 50; i++ {
+			if _, err := graph.GetVertexById(i); err != nil {
+				t.Errorf("Expected vertex %d to exist", i)
+			}
+		}
+	})
+
+	t.Run("Edge density", func(t *testing.T) {
+		const n, p, seeds = 40, 0.2, 20
+		totalEdges := 0
+		for seed := int64(0); seed < seeds; seed++ {
+			graph := GenerateRandom(n, p, seed, unitCost, vertexData, edgeData)
+			totalEdges += graph.GetEdgeCount()
+		}
+
+		density := float64(totalEdges) / float64(seeds*n*(n-1))
+		if density < p-0.02 || density > p+0.02 {
+			t.Errorf("Expected edge density close to %.2f, got %.4f", p, density)
+		}
+	})
+
+	t.Run("Deterministic for the same seed", func(t *testing.T) {
+		first := GenerateRandom(30, 0.3, 42, unitCost, vertexData, edgeData)
+		second := GenerateRandom(30, 0.3, 42, unitCost, vertexData, edgeData)
+
+		firstEdges := first.GetAllEdges(func() EdgeDto[int, float64, string] { return &BasicEdgeDto[int, float64, string]{} })
+		secondEdges := second.GetAllEdges(func() EdgeDto[int, float64, string] { return &BasicEdgeDto[int, float64, string]{} })
+		if len(firstEdges) != len(secondEdges) {
+			t.Fatalf("Expected equal edge counts, got %d and %d", len(firstEdges), len(secondEdges))
+		}
+		for i := range firstEdges {
+			if firstEdges[i].GetOrigin() != secondEdges[i].GetOrigin() || firstEdges[i].GetTarget() != secondEdges[i].GetTarget() {
+				t.Errorf("Expected identical edges at %d", i)
+			}
+		}
+	})
+
+	t.Run("Callbacks", func(t *testing.T) {
+		cost := func(origin int, target int) float64 { return float64(origin*100 + target) }
+		graph := GenerateRandom(10, 1.0, 7, cost, vertexData, edgeData)
+
+		if graph.GetEdgeCount() != 90 {
+			t.Errorf("Expected complete graph with 90 edges, got %d", graph.GetEdgeCount())
+		}
+		graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			if edge.GetCost() != float64(vertex.GetId()*100+edge.GetTargetVertex().GetId()) {
+				t.Errorf("Unexpected cost %f of edge %d->%d", edge.GetCost(), vertex.GetId(), edge.GetTargetVertex().GetId())
+			}
+			if vertex.GetId() == edge.GetTargetVertex().GetId() {
+				t.Errorf("Unexpected self-loop at %d", vertex.GetId())
+			}
+		})
+	})
+}
+
+func TestGenerateGrid(t *testing.T) {
+	t.Run("Orthogonal grid", func(t *testing.T) {
+		graph := GenerateGrid[string, string](3, 4, false)
+
+		if graph.GetVertexCount() != 12 {
+			t.Errorf("Expected 12 vertices, got %d", graph.GetVertexCount())
+		}
+		// 3 * 3 horizontal + 2 * 4 vertical connections in both directions
+		if graph.GetEdgeCount() != 34 {
+			t.Errorf("Expected 34 edges, got %d", graph.GetEdgeCount())
+		}
+
+		corner, _ := graph.GetVertexById(0)
+		if len(corner.GetEdges()) != 2 {
+			t.Errorf("Expected corner to have 2 neighbors, got %d", len(corner.GetEdges()))
+		}
+		inner, _ := graph.GetVertexById(5)
+		if len(inner.GetEdges()) != 4 {
+			t.Errorf("Expected inner vertex to have 4 neighbors, got %d", len(inner.GetEdges()))
+		}
+	})
+
+	t.Run("Diagonal grid", func(t *testing.T) {
+		graph := GenerateGrid[string, string](3, 3, true)
+
+		center, _ := graph.GetVertexById(4)
+		if len(center.GetEdges()) != 8 {
+			t.Errorf("Expected center to have 8 neighbors, got %d", len(center.GetEdges()))
+		}
+		// 12 orthogonal + 8 diagonal connections in both directions
+		if graph.GetEdgeCount() != 40 {
+			t.Errorf("Expected 40 edges, got %d", graph.GetEdgeCount())
+		}
+	})
+
+	t.Run("A* with grid heuristics", func(t *testing.T) {
+		const rows, cols = 10, 15
+		graph := GenerateGrid[string, string](rows, cols, false)
+		end := rows*cols - 1
+
+		astar := NewAStar(graph, GridManhattanHeuristic[string, string](cols))
+		path := astar.FindShortestPath(0, end)
+		if len(path) != rows+cols-1 {
+			t.Errorf("Expected path of %d vertices, got %d", rows+cols-1, len(path))
+		}
+
+		diagonalGraph := GenerateGrid[string, string](rows, cols, true)
+		astar = NewAStar(diagonalGraph, GridEuclideanHeuristic[string, string](cols))
+		diagonalPath := astar.FindShortestPath(0, end)
+		dijkstraPath := NewDijkstra(diagonalGraph).FindShortestPath(0, end)
+		if len(diagonalPath) != len(dijkstraPath) {
+			t.Errorf("Expected A* path length %d to match Dijkstra, got %d", len(dijkstraPath), len(diagonalPath))
+		}
+	})
+}
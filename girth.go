@@ -0,0 +1,65 @@
+package graph
+
+// Girth returns the length (in edges) of the shortest directed cycle in the graph
+// together with the cycle itself (the first vertex is not repeated at the end).
+// Edge costs are ignored, see MinimumWeightCycle for the weighted counterpart.
+// Returns -1 and nil if the graph is acyclic.
+// A breadth-first search is run from every vertex, finding the shortest cycle through it.
+// Time complexity: O(V * (V + E)) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) Girth() (int, []I) {
+	vertexCount := len(g.vertices)
+	distance := make([]int, vertexCount)
+	parent := make([]int, vertexCount)
+	queue := make([]int, 0, vertexCount)
+
+	girth := -1
+	var cycle []I
+
+	for start := range g.vertices {
+		// Initialize BFS state for this start vertex
+		for i := range distance {
+			distance[i] = -1
+			parent[i] = -1
+		}
+		distance[start] = 0
+		queue = append(queue[:0], start)
+
+		// The first edge closing back to the start yields the shortest cycle through it,
+		// because BFS dequeues vertices in non-decreasing distance order
+		closingIdx := -1
+		for head := 0; head < len(queue) && closingIdx < 0; head++ {
+			currentIdx := queue[head]
+			// No shorter cycle can be found from here on
+			if girth >= 0 && distance[currentIdx]+1 >= girth {
+				break
+			}
+			for _, edge := range g.vertices[currentIdx].edges {
+				neighborIdx := edge.targetVertex.GetCustomDataIndex()
+				if neighborIdx == start {
+					closingIdx = currentIdx
+					break
+				}
+				if distance[neighborIdx] < 0 {
+					distance[neighborIdx] = distance[currentIdx] + 1
+					parent[neighborIdx] = currentIdx
+					queue = append(queue, neighborIdx)
+				}
+			}
+		}
+
+		if closingIdx < 0 {
+			continue
+		}
+
+		// Reconstruct the cycle by following parent pointers back to the start
+		length := distance[closingIdx] + 1
+		girth = length
+		cycle = make([]I, length)
+		for i, idx := length-1, closingIdx; idx >= 0; i, idx = i-1, parent[idx] {
+			cycle[i] = g.vertices[idx].id
+		}
+	}
+
+	return girth, cycle
+}
@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGirth(t *testing.T) {
+	t.Run("Triangle has girth 3", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		girth, cycle := graph.Girth()
+
+		if girth != 3 {
+			t.Errorf("Expected girth 3, got %d", girth)
+		}
+
+		if len(cycle) != 3 {
+			t.Errorf("Expected cycle of 3 vertices, got %v", cycle)
+		}
+	})
+
+	t.Run("Square has girth 4", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+
+		graph := builder.BuildDirected()
+		girth, cycle := graph.Girth()
+
+		if girth != 4 {
+			t.Errorf("Expected girth 4, got %d", girth)
+		}
+
+		if len(cycle) != 4 {
+			t.Fatalf("Expected cycle of 4 vertices, got %v", cycle)
+		}
+
+		// Every consecutive pair must be connected by an edge
+		for i := range cycle {
+			from, _ := graph.GetVertexById(cycle[i])
+			to := cycle[(i+1)%len(cycle)]
+			connected := false
+			for _, edge := range from.GetEdges() {
+				if edge.GetTargetVertex().GetId() == to {
+					connected = true
+				}
+			}
+			if !connected {
+				t.Errorf("Expected edge %d -> %d in cycle %v", cycle[i], to, cycle)
+			}
+		}
+	})
+
+	t.Run("Shortest of several cycles is chosen", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(5, 1, 1.0, "edge5-1")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+
+		graph := builder.BuildDirected()
+		girth, _ := graph.Girth()
+
+		if girth != 2 {
+			t.Errorf("Expected girth 2, got %d", girth)
+		}
+	})
+
+	t.Run("Self-loop has girth 1", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 2, 1.0, "edge2-2")
+
+		graph := builder.BuildDirected()
+		girth, cycle := graph.Girth()
+
+		if girth != 1 || len(cycle) != 1 || cycle[0] != 2 {
+			t.Errorf("Expected girth 1 with cycle [2], got %d with %v", girth, cycle)
+		}
+	})
+
+	t.Run("Acyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+		girth, cycle := graph.Girth()
+
+		if girth != -1 || cycle != nil {
+			t.Errorf("Expected (-1, nil) for acyclic graph, got (%d, %v)", girth, cycle)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package graph
+
+// GlobalMinCut finds a global minimum cut of the graph with the Stoer–Wagner algorithm:
+// the partition of the vertices into two non-empty sets with the minimum total weight of
+// the edges between them, without choosing a source and a sink, which identifies the
+// weakest link of the whole network.
+// The graph is treated as undirected: a pair of vertices is connected with the weight
+// equal to the larger of the total costs of the edges between them in either direction,
+// so a pair of opposite edges added with AddBiEdge counts once. Self-loops are ignored.
+// The edge costs must be non-negative.
+// Returns the minimum cut weight and the vertices of one side of the cut, or zero and nil
+// if the graph has less than two vertices. A disconnected graph has a cut of zero weight.
+// Time complexity: O(V^3) where V is the number of vertices.
+// Space complexity: O(V^2) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) GlobalMinCut() (C, []I) {
+	var zero C
+	vertexCount := len(g.vertices)
+	if vertexCount < 2 {
+		return zero, nil
+	}
+
+	weight := make([][]C, vertexCount)
+	for i := range weight {
+		weight[i] = make([]C, vertexCount)
+	}
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			if targetIdx := edge.targetVertex.GetCustomDataIndex(); targetIdx != i {
+				weight[i][targetIdx] += edge.cost
+			}
+		}
+	}
+	for i := 0; i < vertexCount; i++ {
+		for j := i + 1; j < vertexCount; j++ {
+			if weight[j][i] > weight[i][j] {
+				weight[i][j] = weight[j][i]
+			} else {
+				weight[j][i] = weight[i][j]
+			}
+		}
+	}
+
+	// The original vertices merged into each super-vertex, and the super-vertices left
+	members := make([][]int, vertexCount)
+	active := make([]int, vertexCount)
+	for i := range members {
+		members[i] = []int{i}
+		active[i] = i
+	}
+	added := make([]bool, vertexCount)
+	connectivity := make([]C, vertexCount)
+	var bestWeight C
+	var bestSide []int
+
+	for len(active) > 1 {
+		// Maximum adjacency search: add the super-vertex most tightly connected to the
+		// added ones until all are added. The cut between the last one and the rest is
+		// a minimum cut between the last two added.
+		for _, v := range active {
+			added[v] = false
+			connectivity[v] = zero
+		}
+		previous, last := -1, -1
+		for range active {
+			next := -1
+			for _, v := range active {
+				if !added[v] && (next < 0 || connectivity[v] > connectivity[next]) {
+					next = v
+				}
+			}
+			added[next] = true
+			previous, last = last, next
+			for _, v := range active {
+				if !added[v] {
+					connectivity[v] += weight[next][v]
+				}
+			}
+		}
+
+		if bestSide == nil || connectivity[last] < bestWeight {
+			bestWeight = connectivity[last]
+			bestSide = append(bestSide[:0], members[last]...)
+		}
+
+		// Merge the last two super-vertices added
+		for _, v := range active {
+			weight[previous][v] += weight[last][v]
+			weight[v][previous] = weight[previous][v]
+		}
+		weight[previous][previous] = zero
+		members[previous] = append(members[previous], members[last]...)
+		for i, v := range active {
+			if v == last {
+				active = append(active[:i], active[i+1:]...)
+				break
+			}
+		}
+	}
+
+	side := make([]I, len(bestSide))
+	for i, idx := range bestSide {
+		side[i] = g.vertices[idx].id
+	}
+	return bestWeight, side
+}
@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestGraphGlobalMinCut(t *testing.T) {
+	t.Run("Stoer-Wagner paper example", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddBiEdge(1, 2, 2, "")
+		builder.AddBiEdge(1, 5, 3, "")
+		builder.AddBiEdge(2, 3, 3, "")
+		builder.AddBiEdge(2, 5, 2, "")
+		builder.AddBiEdge(2, 6, 2, "")
+		builder.AddBiEdge(3, 4, 4, "")
+		builder.AddBiEdge(3, 7, 2, "")
+		builder.AddBiEdge(4, 7, 2, "")
+		builder.AddBiEdge(4, 8, 2, "")
+		builder.AddBiEdge(5, 6, 3, "")
+		builder.AddBiEdge(6, 7, 1, "")
+		builder.AddBiEdge(7, 8, 3, "")
+
+		graph := builder.BuildDirected()
+		weight, side := graph.GlobalMinCut()
+
+		if weight != 4 {
+			t.Errorf("Expected cut weight 4, got %d", weight)
+		}
+		sort.Ints(side)
+		if !slicesEqual(side, []int{1, 2, 5, 6}) && !slicesEqual(side, []int{3, 4, 7, 8}) {
+			t.Errorf("Expected side [1 2 5 6] or [3 4 7 8], got %v", side)
+		}
+	})
+
+	t.Run("One-way edges count as undirected", func(t *testing.T) {
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddBiEdge("A", "B", 10, "local")
+		builder.AddEdge("B", "C", 1.5, "bridge")
+		builder.AddBiEdge("C", "D", 10, "local")
+
+		graph := builder.BuildDirected()
+		weight, side := graph.GlobalMinCut()
+
+		if weight != 1.5 {
+			t.Errorf("Expected cut weight 1.5, got %f", weight)
+		}
+		if len(side) != 2 {
+			t.Errorf("Expected 2 vertices on the side, got %v", side)
+		}
+	})
+
+	t.Run("Disconnected graph", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddBiEdge(1, 2, 5, "")
+		builder.AddBiEdge(3, 4, 5, "")
+
+		graph := builder.BuildDirected()
+		weight, side := graph.GlobalMinCut()
+
+		if weight != 0 || len(side) == 0 || len(side) == 4 {
+			t.Errorf("Expected a cut of weight 0, got %d (%v)", weight, side)
+		}
+	})
+
+	t.Run("Single vertex", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddVertex(1, "")
+
+		graph := builder.BuildDirected()
+		weight, side := graph.GlobalMinCut()
+
+		if weight != 0 || side != nil {
+			t.Errorf("Expected zero and nil, got %d (%v)", weight, side)
+		}
+	})
+}
@@ -1,6 +1,16 @@
 package graph
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultStringMaxVertices is the maximum number of vertices listed by Graph.String.
+// The rest of a larger graph is summarized with a single line.
+const DefaultStringMaxVertices = 50
 
 // Graph represents a directed graph with vertices and edges.
 // The graph encapsulates edges and vertices with support for custom data types.
@@ -12,6 +22,9 @@ type Graph[I Id, C Cost, V any, E any] struct {
 	customEdgeData   []E            // Array of custom data associated with each edge
 	edgeCount        int            // Total number of directed edges in the graph
 	biEdgeCount      int            // Number of bidirectional edges (unique vertex pairs)
+	// Incoming edges of each vertex, built on demand by BuildReverseIndex
+	predecessors     [][]predecessor[I, C]
+	reverseIndexOnce sync.Once // Guards the lazy build of the predecessors
 }
 
 // GetVertexCount returns the total number of vertices in the graph.
@@ -72,6 +85,75 @@ func (g *Graph[I, C, V, E]) GetEdgeData(edge *Edge[I, C]) (*E, error) {
 	return &g.customEdgeData[edge.customDataIndex], nil
 }
 
+// SetVertexData replaces the custom data associated with the vertex with the given ID.
+// Returns an error if the ID doesn't exist.
+// Time complexity: O(1) due to the idToIndex map.
+func (g *Graph[I, C, V, E]) SetVertexData(id I, data V) error {
+	vertex, err := g.GetVertexById(id)
+	if err != nil {
+		return err
+	}
+	g.customVertexData[vertex.customDataIndex] = data
+	return nil
+}
+
+// SetEdgeData replaces the custom data associated with an edge.
+// Returns an error if the edge is nil or its data index is out of range,
+// e.g. if the edge belongs to another graph.
+func (g *Graph[I, C, V, E]) SetEdgeData(edge *Edge[I, C], data E) error {
+	if edge == nil {
+		return errors.New("edge ptr is nil")
+	}
+	if edge.customDataIndex < 0 || edge.customDataIndex >= len(g.customEdgeData) {
+		return errors.New("index out of range")
+	}
+	g.customEdgeData[edge.customDataIndex] = data
+	return nil
+}
+
+// GetVertexDataById retrieves the custom data associated with the vertex with the given ID.
+// Returns a pointer to the vertex's custom data, or an error if the ID doesn't exist.
+// Time complexity: O(1) due to the idToIndex map.
+func (g *Graph[I, C, V, E]) GetVertexDataById(id I) (*V, error) {
+	vertex, err := g.GetVertexById(id)
+	if err != nil {
+		return nil, err
+	}
+	return &g.customVertexData[vertex.customDataIndex], nil
+}
+
+// GetEdgeDataByEndpoints retrieves the custom data associated with the edge going from
+// one vertex to another. If there are parallel edges, the one added first is used.
+// Returns a pointer to the edge's custom data, or an error if either vertex or the edge
+// doesn't exist.
+// Time complexity: O(D) where D is the out-degree of the origin vertex.
+func (g *Graph[I, C, V, E]) GetEdgeDataByEndpoints(from I, to I) (*E, error) {
+	fromVertex, err := g.GetVertexById(from)
+	if err != nil {
+		return nil, err
+	}
+	toVertex, err := g.GetVertexById(to)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fromVertex.edges {
+		if fromVertex.edges[i].targetVertex == toVertex {
+			return &g.customEdgeData[fromVertex.edges[i].customDataIndex], nil
+		}
+	}
+	return nil, errors.New("edge not found")
+}
+
+// GetVertexIds returns the IDs of all vertices in the order of the vertex indexes.
+// Time complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) GetVertexIds() []I {
+	ids := make([]I, len(g.vertices))
+	for i := range g.vertices {
+		ids[i] = g.vertices[i].id
+	}
+	return ids
+}
+
 // GetAllVertices returns all vertices in the graph as DTOs.
 // Takes a factory function to create new vertex DTOs.
 // Returns a slice of VertexDto objects containing all vertex data.
@@ -147,6 +229,19 @@ func (g *Graph[I, C, V, E]) VisitEdges(visitor func(*Vertex[I, C], *Edge[I, C]))
 	}
 }
 
+// VisitEdgesWhile applies a visitor function to edges in the graph until it returns false.
+// The visitor function receives both the source vertex and the edge.
+// Iteration stops immediately after the visitor returns false.
+func (g *Graph[I, C, V, E]) VisitEdgesWhile(visitor func(*Vertex[I, C], *Edge[I, C]) bool) {
+	for i := range g.vertices {
+		for j := range g.vertices[i].edges {
+			if !visitor(&g.vertices[i], &g.vertices[i].edges[j]) {
+				return
+			}
+		}
+	}
+}
+
 // SomeEdges checks if any edge satisfies the given predicate.
 // Returns true if at least one edge matches the predicate, false otherwise.
 // Stops iteration as soon as a matching edge is found.
@@ -184,6 +279,17 @@ func (g *Graph[I, C, V, E]) VisitVertices(visitor func(*Vertex[I, C])) {
 	}
 }
 
+// VisitVerticesWhile applies a visitor function to vertices in the graph until it returns false.
+// The visitor function receives a pointer to each vertex.
+// Iteration stops immediately after the visitor returns false.
+func (g *Graph[I, C, V, E]) VisitVerticesWhile(visitor func(*Vertex[I, C]) bool) {
+	for i := range g.vertices {
+		if !visitor(&g.vertices[i]) {
+			return
+		}
+	}
+}
+
 // SomeVertices checks if any vertex satisfies the given predicate.
 // Returns true if at least one vertex matches the predicate, false otherwise.
 // Stops iteration as soon as a matching vertex is found.
@@ -207,3 +313,55 @@ func (g *Graph[I, C, V, E]) EveryVertex(predicate func(*Vertex[I, C]) bool) bool
 	}
 	return true
 }
+
+// String returns a compact textual representation of the graph for debugging, with one
+// line per vertex in the order of the vertex indexes, listing the targets and costs of
+// its outgoing edges, e.g. "1 -> [2(10.0), 4(5.0)]". Only the first
+// DefaultStringMaxVertices vertices are listed (see StringN).
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) String() string {
+	return g.StringN(DefaultStringMaxVertices)
+}
+
+// StringN returns the same representation of the graph as String, but lists only the
+// first maxVertices vertices, followed by a line with the number of the omitted ones.
+// A negative maxVertices lists all the vertices.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) StringN(maxVertices int) string {
+	var sb strings.Builder
+	for i := range g.vertices {
+		if i == maxVertices {
+			fmt.Fprintf(&sb, "... %d more vertices\n", len(g.vertices)-i)
+			break
+		}
+		fmt.Fprintf(&sb, "%v -> [", g.vertices[i].id)
+		for j, edge := range g.vertices[i].edges {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "%v(%s)", edge.targetVertex.id, formatCost(edge.cost))
+		}
+		sb.WriteString("]\n")
+	}
+	return sb.String()
+}
+
+// formatCost formats the cost for String, keeping a decimal point in the floating-point
+// costs, so that they can be told apart from the integer ones.
+func formatCost[C Cost](cost C) string {
+	var s string
+	switch c := any(cost).(type) {
+	case float32:
+		s = strconv.FormatFloat(float64(c), 'g', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(c, 'g', -1, 64)
+	default:
+		return fmt.Sprint(cost)
+	}
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
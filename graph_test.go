@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -541,4 +542,219 @@ func TestGraph(t *testing.T) {
 			t.Error("Expected EveryVertex to return false when some vertices don't match predicate")
 		}
 	})
+
+	t.Run("Visit edges while", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		graph := builder.BuildDirected()
+
+		visitCount := 0
+		graph.VisitEdgesWhile(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) bool {
+			visitCount++
+			return visitCount < 2
+		})
+		if visitCount != 2 {
+			t.Errorf("Expected iteration to stop after 2 edges, got %d", visitCount)
+		}
+
+		visitCount = 0
+		graph.VisitEdgesWhile(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) bool {
+			visitCount++
+			return true
+		})
+		if visitCount != 4 {
+			t.Errorf("Expected all 4 edges to be visited, got %d", visitCount)
+		}
+	})
+
+	t.Run("Visit vertices while", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "A")
+		builder.AddVertex(2, "B")
+		builder.AddVertex(3, "C")
+		builder.AddVertex(4, "D")
+		graph := builder.BuildDirected()
+
+		visitCount := 0
+		graph.VisitVerticesWhile(func(vertex *Vertex[int, float64]) bool {
+			visitCount++
+			return visitCount < 3
+		})
+		if visitCount != 3 {
+			t.Errorf("Expected iteration to stop after 3 vertices, got %d", visitCount)
+		}
+
+		visitCount = 0
+		graph.VisitVerticesWhile(func(vertex *Vertex[int, float64]) bool {
+			visitCount++
+			return true
+		})
+		if visitCount != 4 {
+			t.Errorf("Expected all 4 vertices to be visited, got %d", visitCount)
+		}
+	})
+}
+
+func TestGraphDataLookups(t *testing.T) {
+	builder := &Builder[string, float64, int, string]{}
+	builder.AddVertex("A", 1)
+	builder.AddVertex("B", 2)
+	builder.AddEdge("A", "B", 1, "first")
+	builder.AddEdge("A", "B", 2, "parallel")
+	builder.AddEdge("B", "C", 1, "edgeB-C")
+	graph := builder.BuildDirected()
+
+	t.Run("Vertex data by ID", func(t *testing.T) {
+		data, err := graph.GetVertexDataById("B")
+		if err != nil || *data != 2 {
+			t.Errorf("Expected data 2, got %v (%v)", data, err)
+		}
+
+		if data, err := graph.GetVertexDataById("X"); err == nil || data != nil {
+			t.Errorf("Expected an error for a missing vertex, got %v", data)
+		}
+	})
+
+	t.Run("Edge data by endpoints", func(t *testing.T) {
+		data, err := graph.GetEdgeDataByEndpoints("A", "B")
+		if err != nil || *data != "first" {
+			t.Errorf("Expected data first, got %v (%v)", data, err)
+		}
+
+		data, err = graph.GetEdgeDataByEndpoints("B", "C")
+		if err != nil || *data != "edgeB-C" {
+			t.Errorf("Expected data edgeB-C, got %v (%v)", data, err)
+		}
+
+		if data, err := graph.GetEdgeDataByEndpoints("B", "A"); err == nil || data != nil {
+			t.Errorf("Expected an error for a missing edge, got %v", data)
+		}
+		if data, err := graph.GetEdgeDataByEndpoints("X", "A"); err == nil || data != nil {
+			t.Errorf("Expected an error for a missing origin, got %v", data)
+		}
+		if data, err := graph.GetEdgeDataByEndpoints("A", "X"); err == nil || data != nil {
+			t.Errorf("Expected an error for a missing target, got %v", data)
+		}
+	})
+}
+
+func TestGraphSetData(t *testing.T) {
+	builder := &Builder[string, float64, int, string]{}
+	builder.AddVertex("A", 1)
+	builder.AddEdge("A", "B", 1, "old")
+	graph := builder.BuildDirected()
+
+	t.Run("Set vertex data", func(t *testing.T) {
+		if err := graph.SetVertexData("A", 10); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		data, _ := graph.GetVertexDataById("A")
+		if *data != 10 {
+			t.Errorf("Expected data 10, got %d", *data)
+		}
+
+		if err := graph.SetVertexData("X", 10); err == nil {
+			t.Error("Expected error for non-existent vertex")
+		}
+	})
+
+	t.Run("Set edge data", func(t *testing.T) {
+		vertex, _ := graph.GetVertexById("A")
+		edge := &vertex.GetEdges()[0]
+		if err := graph.SetEdgeData(edge, "new"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		data, _ := graph.GetEdgeData(edge)
+		if *data != "new" {
+			t.Errorf("Expected data new, got %s", *data)
+		}
+
+		if err := graph.SetEdgeData(nil, "new"); err == nil {
+			t.Error("Expected error for nil edge")
+		}
+		if err := graph.SetEdgeData(&Edge[string, float64]{customDataIndex: 5}, "new"); err == nil {
+			t.Error("Expected error for an edge of another graph")
+		}
+	})
+}
+
+func TestGraphGetVertexIds(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(3, 1, 1, "edge3-1")
+	builder.AddEdge(1, 2, 1, "edge1-2")
+	builder.AddVertex(5, "vertex5")
+	graph := builder.BuildDirected()
+
+	var expected []int
+	graph.VisitVertices(func(vertex *Vertex[int, float64]) {
+		expected = append(expected, vertex.GetId())
+	})
+
+	if ids := graph.GetVertexIds(); !slicesEqual(ids, expected) {
+		t.Errorf("Expected ids %v, got %v", expected, ids)
+	}
+}
+
+func TestGraphString(t *testing.T) {
+	t.Run("Small graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 10, "edge1-2")
+		builder.AddEdge(1, 4, 5, "edge1-4")
+		builder.AddEdge(2, 4, 2.5, "edge2-4")
+		builder.AddVertex(3, "vertex3")
+
+		graph := builder.BuildDirected()
+		expected := "1 -> [2(10.0), 4(5.0)]\n2 -> [4(2.5)]\n4 -> []\n3 -> []\n"
+
+		if graph.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, graph.String())
+		}
+	})
+
+	t.Run("Integer costs", func(t *testing.T) {
+		builder := &Builder[string, int, string, string]{}
+		builder.AddBiEdge("a", "b", 3, "edge")
+
+		graph := builder.BuildDirected()
+		expected := "a -> [b(3)]\nb -> [a(3)]\n"
+
+		if graph.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, graph.String())
+		}
+	})
+
+	t.Run("Large graph is truncated", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(2, 3, 1, "edge2-3")
+		builder.AddEdge(3, 4, 1, "edge3-4")
+
+		graph := builder.BuildDirected()
+		expected := "1 -> [2(1)]\n2 -> [3(1)]\n... 2 more vertices\n"
+
+		if graph.StringN(2) != expected {
+			t.Errorf("Expected %q, got %q", expected, graph.StringN(2))
+		}
+		if graph.StringN(-1) != graph.StringN(4) || strings.Contains(graph.StringN(-1), "more vertices") {
+			t.Errorf("Expected all the vertices to be listed, got %q", graph.StringN(-1))
+		}
+	})
+
+	t.Run("String uses the default limit", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		for i := 0; i < DefaultStringMaxVertices+10; i++ {
+			builder.AddEdge(i, i+1, 1, "")
+		}
+
+		graph := builder.BuildDirected()
+
+		if graph.String() != graph.StringN(DefaultStringMaxVertices) || !strings.HasSuffix(graph.String(), "... 11 more vertices\n") {
+			t.Errorf("Expected the vertices beyond %d to be omitted, got %q", DefaultStringMaxVertices, graph.String())
+		}
+	})
 }
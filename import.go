@@ -0,0 +1,55 @@
+package graph
+
+import "sort"
+
+// FromEdgeList builds a directed graph from a list of edges, which saves the boilerplate
+// of filling a Builder for simple data. The vertices are created implicitly from the
+// edge endpoints in the order they first appear and get the zero value of the custom
+// vertex data type.
+// Since the custom vertex data type can't be inferred from the arguments, it must be
+// specified explicitly, e.g. FromEdgeList[int, float64, string, string](edges).
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func FromEdgeList[I Id, C Cost, V any, E any](edges []BasicEdgeDto[I, C, E]) *Graph[I, C, V, E] {
+	builder := &Builder[I, C, V, E]{}
+	builder.Reserve(0, len(edges))
+	for i := range edges {
+		builder.AddEdgeDto(&edges[i])
+	}
+	return builder.BuildDirected()
+}
+
+// FromAdjacencyList builds a directed graph from an adjacency list mapping every vertex
+// to the targets of its outgoing edges, whose costs are produced by the costOf callback.
+// The vertices are created implicitly, including the targets that aren't keys of the map,
+// and the keys without targets become isolated vertices. Since the iteration order of a
+// map is random, the keys are processed in ascending order to keep the vertex order stable.
+// The vertices and edges get zero values of the custom data types.
+// Time complexity: O(V * log(V) + E) where V is the number of vertices and E is the
+// number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func FromAdjacencyList[I Id, C Cost, V any, E any](adj map[I][]I, costOf func(origin I, target I) C) *Graph[I, C, V, E] {
+	origins := make([]I, 0, len(adj))
+	edgeCount := 0
+	for origin, targets := range adj {
+		origins = append(origins, origin)
+		edgeCount += len(targets)
+	}
+	sort.Slice(origins, func(i, j int) bool { return origins[i] < origins[j] })
+
+	builder := &Builder[I, C, V, E]{}
+	builder.Reserve(len(origins), edgeCount)
+	var vertexData V
+	var edgeData E
+	for _, origin := range origins {
+		for _, target := range adj[origin] {
+			builder.AddEdge(origin, target, costOf(origin, target), edgeData)
+		}
+	}
+	for _, origin := range origins {
+		if len(adj[origin]) == 0 {
+			builder.AddVertex(origin, vertexData)
+		}
+	}
+	return builder.BuildDirected()
+}
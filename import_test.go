@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestFromEdgeList(t *testing.T) {
+	t.Run("Same as the builder", func(t *testing.T) {
+		edges := []BasicEdgeDto[int, float64, string]{
+			{Origin: 1, Target: 2, Cost: 1.5, Data: "edge1-2"},
+			{Origin: 2, Target: 3, Cost: 2, Data: "edge2-3"},
+			{Origin: 1, Target: 3, Cost: 4, Data: "edge1-3"},
+			{Origin: 4, Target: 1, Cost: 1, Data: "edge4-1"},
+		}
+		graph := FromEdgeList[int, float64, string, string](edges)
+
+		builder := &Builder[int, float64, string, string]{}
+		for _, edge := range edges {
+			builder.AddEdge(edge.Origin, edge.Target, edge.Cost, edge.Data)
+		}
+		expected := builder.BuildDirected()
+
+		if graph.GetVertexCount() != expected.GetVertexCount() {
+			t.Fatalf("Expected %d vertices, got %d", expected.GetVertexCount(), graph.GetVertexCount())
+		}
+		for i := 0; i < expected.GetVertexCount(); i++ {
+			expectedVertex, _ := expected.GetVertexByIndex(i)
+			vertex, _ := graph.GetVertexByIndex(i)
+			if vertex.GetId() != expectedVertex.GetId() {
+				t.Errorf("Expected vertex %d at %d, got %d", expectedVertex.GetId(), i, vertex.GetId())
+			}
+		}
+
+		newEdge := func() EdgeDto[int, float64, string] { return &BasicEdgeDto[int, float64, string]{} }
+		expectedEdges := expected.GetAllEdges(newEdge)
+		actualEdges := graph.GetAllEdges(newEdge)
+		if len(actualEdges) != len(expectedEdges) {
+			t.Fatalf("Expected %d edges, got %d", len(expectedEdges), len(actualEdges))
+		}
+		for i := range expectedEdges {
+			if *actualEdges[i].(*BasicEdgeDto[int, float64, string]) != *expectedEdges[i].(*BasicEdgeDto[int, float64, string]) {
+				t.Errorf("Expected edge %v, got %v", expectedEdges[i], actualEdges[i])
+			}
+		}
+	})
+
+	t.Run("Empty list", func(t *testing.T) {
+		graph := FromEdgeList[int, float64, string, string](nil)
+
+		if graph.GetVertexCount() != 0 || graph.GetEdgeCount() != 0 {
+			t.Errorf("Expected an empty graph, got %d vertices and %d edges",
+				graph.GetVertexCount(), graph.GetEdgeCount())
+		}
+	})
+}
+
+func TestFromAdjacencyList(t *testing.T) {
+	adj := map[string][]string{
+		"c": {"a"},
+		"a": {"b", "c"},
+		"b": {"d"},
+		"e": nil,
+	}
+	graph := FromAdjacencyList[string, int, string, string](adj, func(origin string, target string) int {
+		return len(origin + target)
+	})
+
+	if graph.GetVertexCount() != 5 {
+		t.Errorf("Expected 5 vertices, got %d", graph.GetVertexCount())
+	}
+	if graph.GetEdgeCount() != 4 {
+		t.Errorf("Expected 4 edges, got %d", graph.GetEdgeCount())
+	}
+
+	var ids []string
+	graph.VisitVertices(func(vertex *Vertex[string, int]) {
+		ids = append(ids, vertex.GetId())
+	})
+	if !slicesEqualString(ids, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Expected vertices [a b c d e], got %v", ids)
+	}
+
+	vertex, _ := graph.GetVertexById("a")
+	edges := vertex.GetEdges()
+	if len(edges) != 2 || edges[0].GetTargetVertex().GetId() != "b" || edges[1].GetTargetVertex().GetId() != "c" {
+		t.Errorf("Expected edges a->b and a->c, got %v", edges)
+	}
+	if edges[0].GetCost() != 2 {
+		t.Errorf("Expected cost 2, got %d", edges[0].GetCost())
+	}
+}
@@ -0,0 +1,50 @@
+package graph
+
+import "sort"
+
+// MaximalIndependentSet finds a maximal independent set, i.e. a set of mutually
+// non-adjacent vertices, e.g. for scheduling items that mustn't conflict with each other.
+// The graph is treated as undirected. The vertices are considered greedily in the order
+// of ascending degree, ties broken in favor of the vertex added to the graph first, and
+// each one is picked unless it's adjacent to an already picked one. A vertex with
+// a self-loop conflicts with itself and is never picked.
+// NOTE: The set is maximal (no vertex can be added to it) but not necessarily maximum
+// (the largest possible), since finding the latter is NP-hard. Picking the low-degree
+// vertices first tends to give larger sets though.
+// Returns the picked vertices in the order they were picked.
+// Time complexity: O(V * log(V) + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) MaximalIndependentSet() []I {
+	adjacency := g.undirectedAdjacency()
+	order := make([]int, len(g.vertices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return len(adjacency[order[a]]) < len(adjacency[order[b]])
+	})
+
+	blocked := make([]bool, len(g.vertices))
+	var set []I
+	for _, idx := range order {
+		if blocked[idx] {
+			continue
+		}
+		hasSelfLoop := false
+		for _, neighborIdx := range adjacency[idx] {
+			if neighborIdx == idx {
+				hasSelfLoop = true
+				break
+			}
+		}
+		if hasSelfLoop {
+			continue
+		}
+		set = append(set, g.vertices[idx].id)
+		for _, neighborIdx := range adjacency[idx] {
+			blocked[neighborIdx] = true
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphMaximalIndependentSet(t *testing.T) {
+	checkIndependentSet := func(t *testing.T, graph *Graph[int, float64, string, string], set []int) {
+		inSet := make(map[int]bool, len(set))
+		for _, id := range set {
+			inSet[id] = true
+		}
+		conflicts := func(a int, b int) bool {
+			for _, pair := range [][2]int{{a, b}, {b, a}} {
+				vertex, _ := graph.GetVertexById(pair[0])
+				for _, edge := range vertex.GetEdges() {
+					if edge.GetTargetVertex().GetId() == pair[1] {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+		graph.VisitVertices(func(vertex *Vertex[int, float64]) {
+			id := vertex.GetId()
+			conflicting := conflicts(id, id)
+			for _, member := range set {
+				if member != id && conflicts(id, member) {
+					conflicting = true
+				}
+			}
+			if inSet[id] && conflicting {
+				t.Errorf("Expected no conflicts of member %d", id)
+			}
+			if !inSet[id] && !conflicting {
+				t.Errorf("Expected vertex %d to conflict with the set, since it could be added", id)
+			}
+		})
+	}
+
+	t.Run("Star prefers the leaves", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1, "edge")
+		builder.AddBiEdge(1, 3, 1, "edge")
+		builder.AddBiEdge(1, 4, 1, "edge")
+		builder.AddBiEdge(1, 5, 1, "edge")
+
+		graph := builder.BuildDirected()
+		set := graph.MaximalIndependentSet()
+
+		if !slicesEqual(set, []int{2, 3, 4, 5}) {
+			t.Errorf("Expected set [2 3 4 5], got %v", set)
+		}
+		checkIndependentSet(t, graph, set)
+	})
+
+	t.Run("Self-loops and isolated vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 1, 1, "loop")
+		builder.AddEdge(1, 2, 1, "edge")
+		builder.AddVertex(3, "vertex3")
+
+		graph := builder.BuildDirected()
+		set := graph.MaximalIndependentSet()
+
+		if !slicesEqual(set, []int{3, 2}) {
+			t.Errorf("Expected set [3 2], got %v", set)
+		}
+		checkIndependentSet(t, graph, set)
+	})
+
+	t.Run("Random graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(40, 0.1, seed,
+				func(origin int, target int) float64 { return 1 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			checkIndependentSet(t, graph, graph.MaximalIndependentSet())
+		}
+	})
+}
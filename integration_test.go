@@ -160,6 +160,21 @@ func TestIntegration(t *testing.T) {
 		if majorCities != 2 { // NYC and PHL
 			t.Errorf("Expected 2 major cities, got %d", majorCities)
 		}
+
+		// Test the structure profile
+		stats := graph.Stats()
+		if stats.Density != 0.5 { // 6 of 4*3 possible roads
+			t.Errorf("Expected density 0.5, got %f", stats.Density)
+		}
+		if stats.MinOutDegree != 1 || stats.MaxOutDegree != 3 { // BOS, DC, PHL and NYC
+			t.Errorf("Expected out-degrees from 1 to 3, got %d to %d", stats.MinOutDegree, stats.MaxOutDegree)
+		}
+		if stats.MinInDegree != 1 || stats.MaxInDegree != 2 { // BOS, PHL and NYC, DC
+			t.Errorf("Expected in-degrees from 1 to 2, got %d to %d", stats.MinInDegree, stats.MaxInDegree)
+		}
+		if stats.SourceCount != 0 || stats.SinkCount != 0 || stats.IsolatedCount != 0 {
+			t.Errorf("Expected no sources, sinks or isolated cities, got %+v", stats)
+		}
 	})
 
 	t.Run("Task dependency graph", func(t *testing.T) {
@@ -276,3 +291,16 @@ type Dependency struct {
 	Type     string
 	Critical bool
 }
+
+// buildTaskDependencyGraph builds the Design -> Implement -> Test -> Deploy task graph.
+func buildTaskDependencyGraph() *Graph[int, int, Task, Dependency] {
+	builder := &Builder[int, int, Task, Dependency]{}
+	builder.AddVertex(1, Task{Name: "Design", Duration: 5, Priority: "High"})
+	builder.AddVertex(2, Task{Name: "Implement", Duration: 10, Priority: "Medium"})
+	builder.AddVertex(3, Task{Name: "Test", Duration: 3, Priority: "High"})
+	builder.AddVertex(4, Task{Name: "Deploy", Duration: 2, Priority: "Low"})
+	builder.AddEdge(1, 2, 0, Dependency{Type: "Blocks", Critical: true})
+	builder.AddEdge(2, 3, 0, Dependency{Type: "Blocks", Critical: true})
+	builder.AddEdge(3, 4, 0, Dependency{Type: "Blocks", Critical: false})
+	return builder.BuildDirected()
+}
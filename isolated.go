@@ -0,0 +1,45 @@
+package graph
+
+// IsolatedVertices returns the vertices without any incoming or outgoing edges,
+// in the order of the vertex indexes. A vertex with a self-loop isn't isolated.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) IsolatedVertices() []I {
+	connected := g.connectedVertexFlags()
+	var isolated []I
+	for i := range g.vertices {
+		if !connected[i] {
+			isolated = append(isolated, g.vertices[i].id)
+		}
+	}
+	return isolated
+}
+
+// RemoveIsolated builds a copy of the graph without the isolated vertices (see
+// IsolatedVertices). All the edges and the custom data are preserved.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) RemoveIsolated() *Graph[I, C, V, E] {
+	connected := g.connectedVertexFlags()
+	ids := make([]I, 0, len(g.vertices))
+	for i := range g.vertices {
+		if connected[i] {
+			ids = append(ids, g.vertices[i].id)
+		}
+	}
+	return g.Subgraph(ids)
+}
+
+// connectedVertexFlags marks the vertices that are an endpoint of at least one edge.
+func (g *Graph[I, C, V, E]) connectedVertexFlags() []bool {
+	connected := make([]bool, len(g.vertices))
+	for i := range g.vertices {
+		if len(g.vertices[i].edges) > 0 {
+			connected[i] = true
+		}
+		for _, edge := range g.vertices[i].edges {
+			connected[edge.targetVertex.GetCustomDataIndex()] = true
+		}
+	}
+	return connected
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphIsolatedVertices(t *testing.T) {
+	builder := &Builder[string, float64, string, string]{}
+	builder.AddVertex("A", "vertexA")
+	builder.AddVertex("F", "vertexF")
+	builder.AddVertex("G", "vertexG")
+	builder.AddEdge("A", "B", 1, "edgeA-B")
+	builder.AddEdge("C", "B", 2, "edgeC-B")
+	builder.AddEdge("D", "D", 3, "edgeD-D")
+
+	graph := builder.BuildDirected()
+
+	t.Run("Isolated vertices", func(t *testing.T) {
+		isolated := graph.IsolatedVertices()
+
+		if !slicesEqualString(isolated, []string{"F", "G"}) {
+			t.Errorf("Expected isolated vertices [F G], got %v", isolated)
+		}
+	})
+
+	t.Run("Remove isolated vertices", func(t *testing.T) {
+		cleaned := graph.RemoveIsolated()
+
+		if cleaned.GetVertexCount() != 4 {
+			t.Errorf("Expected 4 vertices, got %d", cleaned.GetVertexCount())
+		}
+		for _, id := range []string{"F", "G"} {
+			if _, err := cleaned.GetVertexById(id); err == nil {
+				t.Errorf("Expected vertex %s to be removed", id)
+			}
+		}
+		if cleaned.GetEdgeCount() != graph.GetEdgeCount() {
+			t.Errorf("Expected %d edges, got %d", graph.GetEdgeCount(), cleaned.GetEdgeCount())
+		}
+		if len(cleaned.IsolatedVertices()) != 0 {
+			t.Errorf("Expected no isolated vertices, got %v", cleaned.IsolatedVertices())
+		}
+
+		vertex, _ := cleaned.GetVertexById("A")
+		data, _ := cleaned.GetVertexData(vertex)
+		if *data != "vertexA" {
+			t.Errorf("Expected vertex data vertexA, got %s", *data)
+		}
+		edge := &vertex.GetEdges()[0]
+		edgeData, _ := cleaned.GetEdgeData(edge)
+		if edge.GetTargetVertex().GetId() != "B" || edge.GetCost() != 1 || *edgeData != "edgeA-B" {
+			t.Errorf("Expected edge A->B (1, edgeA-B), got A->%s (%v, %s)",
+				edge.GetTargetVertex().GetId(), edge.GetCost(), *edgeData)
+		}
+	})
+
+	t.Run("No isolated vertices", func(t *testing.T) {
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddEdge("A", "B", 1, "edgeA-B")
+
+		graph := builder.BuildDirected()
+
+		if isolated := graph.IsolatedVertices(); isolated != nil {
+			t.Errorf("Expected nil, got %v", isolated)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package graph
+
+// coreNumbers computes the coreness of every vertex of the undirected interpretation
+// of the graph with the peeling algorithm of Batagelj and Zaversnik: the vertices are
+// removed in the order of their current degree, kept in buckets, and the coreness of
+// a vertex is its degree at the moment of the removal.
+// Self-loops and parallel edges are ignored.
+// Returns the core numbers indexed by vertex index.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) coreNumbers() []int {
+	adjacency := g.undirectedAdjacency()
+	vertexCount := len(g.vertices)
+	degree := make([]int, vertexCount)
+	maxDegree := 0
+	for i := range adjacency {
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				degree[i]++
+			}
+		}
+		if degree[i] > maxDegree {
+			maxDegree = degree[i]
+		}
+	}
+
+	// Sort the vertices by degree with a counting sort. bucketStart[d] is the position
+	// of the first vertex with degree d in the order.
+	bucketStart := make([]int, maxDegree+2)
+	for i := 0; i < vertexCount; i++ {
+		bucketStart[degree[i]+1]++
+	}
+	for d := 1; d < len(bucketStart); d++ {
+		bucketStart[d] += bucketStart[d-1]
+	}
+	order := make([]int, vertexCount)
+	position := make([]int, vertexCount)
+	next := append([]int(nil), bucketStart...)
+	for i := 0; i < vertexCount; i++ {
+		position[i] = next[degree[i]]
+		order[position[i]] = i
+		next[degree[i]]++
+	}
+
+	// Peel the vertices off in the order, moving each higher-degree neighbor of
+	// the removed vertex to the front of its bucket and into the bucket below
+	for p := 0; p < vertexCount; p++ {
+		idx := order[p]
+		for _, neighborIdx := range adjacency[idx] {
+			if neighborIdx == idx || degree[neighborIdx] <= degree[idx] {
+				continue
+			}
+			neighborDegree := degree[neighborIdx]
+			frontPosition := bucketStart[neighborDegree]
+			frontIdx := order[frontPosition]
+			if frontIdx != neighborIdx {
+				order[frontPosition], order[position[neighborIdx]] = neighborIdx, frontIdx
+				position[frontIdx] = position[neighborIdx]
+				position[neighborIdx] = frontPosition
+			}
+			bucketStart[neighborDegree]++
+			degree[neighborIdx]--
+		}
+	}
+
+	return degree
+}
+
+// CoreNumbers computes the coreness of every vertex, i.e. the largest k such that
+// the vertex belongs to the k-core of the graph (see KCore).
+// The graph is treated as undirected, self-loops and parallel edges are ignored.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) CoreNumbers() map[I]int {
+	cores := g.coreNumbers()
+	result := make(map[I]int, len(g.vertices))
+	for i := range g.vertices {
+		result[g.vertices[i].id] = cores[i]
+	}
+	return result
+}
+
+// KCore returns the vertices of the k-core of the graph, i.e. the maximal subgraph
+// where every vertex has at least k neighbors within the subgraph, which is useful
+// for pruning the peripheral vertices of large networks.
+// The graph is treated as undirected, self-loops and parallel edges are ignored.
+// The vertices are listed in the order they were added to the graph.
+// Returns an empty slice if the k-core is empty.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) KCore(k int) []I {
+	cores := g.coreNumbers()
+	result := []I{}
+	for i := range g.vertices {
+		if cores[i] >= k {
+			result = append(result, g.vertices[i].id)
+		}
+	}
+	return result
+}
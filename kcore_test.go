@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestCoreNumbers(t *testing.T) {
+	t.Run("Triangle with a pendant vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		cores := graph.CoreNumbers()
+
+		expected := map[int]int{1: 2, 2: 2, 3: 2, 4: 1}
+		for id, core := range expected {
+			if cores[id] != core {
+				t.Errorf("Expected coreness %d for vertex %d, got %d", core, id, cores[id])
+			}
+		}
+	})
+
+	t.Run("Complete graph with a tail and an isolated vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 1; i <= 4; i++ {
+			for j := i + 1; j <= 4; j++ {
+				builder.AddBiEdge(i, j, 1.0, "edge")
+			}
+		}
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(5, 6, 1.0, "edge5-6")
+		builder.AddEdge(6, 6, 1.0, "loop")
+		builder.AddVertex(7, "isolated")
+
+		graph := builder.BuildDirected()
+		cores := graph.CoreNumbers()
+
+		expected := map[int]int{1: 3, 2: 3, 3: 3, 4: 3, 5: 1, 6: 1, 7: 0}
+		for id, core := range expected {
+			if cores[id] != core {
+				t.Errorf("Expected coreness %d for vertex %d, got %d", core, id, cores[id])
+			}
+		}
+	})
+
+	t.Run("Random graphs match the definition", func(t *testing.T) {
+		for seed := int64(0); seed < 10; seed++ {
+			graph := GenerateRandom(30, 0.2, seed,
+				func(origin int, target int) float64 { return 1.0 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			cores := graph.CoreNumbers()
+			adjacency := graph.undirectedAdjacency()
+
+			// Every vertex of the k-core has at least k neighbors within it
+			for k := 1; k <= 10; k++ {
+				for i, neighbors := range adjacency {
+					if cores[graph.vertices[i].id] < k {
+						continue
+					}
+					count := 0
+					for _, neighborIdx := range neighbors {
+						if neighborIdx != i && cores[graph.vertices[neighborIdx].id] >= k {
+							count++
+						}
+					}
+					if count < k {
+						t.Errorf("Seed %d: vertex %d of the %d-core has only %d neighbors in it", seed, graph.vertices[i].id, k, count)
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestKCore(t *testing.T) {
+	t.Run("Triangle with a pendant vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+
+		if core := graph.KCore(1); !slicesEqual(core, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected 1-core [1 2 3 4], got %v", core)
+		}
+		if core := graph.KCore(2); !slicesEqual(core, []int{1, 2, 3}) {
+			t.Errorf("Expected 2-core [1 2 3], got %v", core)
+		}
+		if core := graph.KCore(3); len(core) != 0 {
+			t.Errorf("Expected empty 3-core, got %v", core)
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if core := graph.KCore(0); len(core) != 0 {
+			t.Errorf("Expected empty core, got %v", core)
+		}
+		if cores := graph.CoreNumbers(); len(cores) != 0 {
+			t.Errorf("Expected no core numbers, got %v", cores)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package graph
+
+// LCA finds the lowest common ancestors of two vertices in a DAG, e.g. the merge bases
+// of two commits in a version graph. An edge goes from the parent to the child, every
+// vertex is an ancestor of itself, and a common ancestor is the lowest one if none of
+// its descendants is a common ancestor too. A DAG may have several of them.
+// The ancestor sets are computed with a backward BFS from both vertices and intersected.
+// Since the set of the common ancestors is closed under taking ancestors, a common
+// ancestor is the lowest one if none of its children is a common ancestor.
+// The result is ordered as the vertices were added to the graph, and it's empty if
+// the vertices have no common ancestor.
+// Returns ErrCycleDetected if the graph isn't a DAG, or an error if either vertex
+// doesn't exist.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) LCA(a I, b I) ([]I, error) {
+	aVertex, err := g.GetVertexById(a)
+	if err != nil {
+		return nil, err
+	}
+	bVertex, err := g.GetVertexById(b)
+	if err != nil {
+		return nil, err
+	}
+	if !g.IsDAG() {
+		return nil, ErrCycleDetected
+	}
+
+	predecessors := make([][]int, len(g.vertices))
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			predecessors[targetIdx] = append(predecessors[targetIdx], i)
+		}
+	}
+
+	// ancestors marks the vertices reaching the given one, including itself
+	ancestors := func(startIdx int) []bool {
+		marked := make([]bool, len(g.vertices))
+		marked[startIdx] = true
+		queue := []int{startIdx}
+		for head := 0; head < len(queue); head++ {
+			for _, predecessorIdx := range predecessors[queue[head]] {
+				if !marked[predecessorIdx] {
+					marked[predecessorIdx] = true
+					queue = append(queue, predecessorIdx)
+				}
+			}
+		}
+		return marked
+	}
+	common := ancestors(aVertex.GetCustomDataIndex())
+	bAncestors := ancestors(bVertex.GetCustomDataIndex())
+	for i := range common {
+		common[i] = common[i] && bAncestors[i]
+	}
+
+	result := []I{}
+	for i := range g.vertices {
+		if !common[i] {
+			continue
+		}
+		lowest := true
+		for _, edge := range g.vertices[i].edges {
+			if common[edge.targetVertex.GetCustomDataIndex()] {
+				lowest = false
+				break
+			}
+		}
+		if lowest {
+			result = append(result, g.vertices[i].id)
+		}
+	}
+	return result, nil
+}
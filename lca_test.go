@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLCA(t *testing.T) {
+	t.Run("Diamond", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// 0 -> 1 -> {2, 3} -> 4
+		builder.AddEdge(0, 1, 1.0, "edge0-1")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+
+		lca, err := graph.LCA(2, 3)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !slicesEqual(lca, []int{1}) {
+			t.Errorf("Expected [1], got %v", lca)
+		}
+
+		// A vertex is an ancestor of itself
+		lca, _ = graph.LCA(1, 4)
+		if !slicesEqual(lca, []int{1}) {
+			t.Errorf("Expected [1], got %v", lca)
+		}
+		lca, _ = graph.LCA(4, 4)
+		if !slicesEqual(lca, []int{4}) {
+			t.Errorf("Expected [4], got %v", lca)
+		}
+	})
+
+	t.Run("Several lowest common ancestors", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Criss-cross merge: both 3 and 4 descend from 1 and 2
+		builder.AddEdge(0, 1, 1.0, "edge0-1")
+		builder.AddEdge(0, 2, 1.0, "edge0-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 4, 1.0, "edge1-4")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+
+		graph := builder.BuildDirected()
+		lca, err := graph.LCA(3, 4)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(lca) != 2 || !((lca[0] == 1 && lca[1] == 2) || (lca[0] == 2 && lca[1] == 1)) {
+			t.Errorf("Expected 1 and 2, got %v", lca)
+		}
+	})
+
+	t.Run("No common ancestor", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		lca, err := graph.LCA(2, 4)
+		if err != nil || len(lca) != 0 {
+			t.Errorf("Expected an empty result, got %v and %v", lca, err)
+		}
+	})
+
+	t.Run("Cyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+
+		graph := builder.BuildDirected()
+		if _, err := graph.LCA(1, 2); !errors.Is(err, ErrCycleDetected) {
+			t.Errorf("Expected ErrCycleDetected, got %v", err)
+		}
+	})
+
+	t.Run("Non-existent vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if _, err := graph.LCA(1, 99); err == nil {
+			t.Errorf("Expected an error for a non-existent vertex")
+		}
+	})
+}
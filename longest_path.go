@@ -0,0 +1,111 @@
+package graph
+
+// LongestPathViaCondensation computes the cost of the longest path from start to end
+// in a graph that may contain cycles, where the longest simple path is NP-hard.
+// The strongly connected components are condensed into single super-vertices and
+// traversing a component internally is considered free (zero cost), then the longest
+// path is found over the condensation, which is a DAG, counting only the costs of
+// the edges between different components. So cycles contribute nothing instead of
+// making the answer diverge, and for a DAG the result is the plain longest path cost.
+// Returns ErrNoPath if the end isn't reachable from the start, or an error if either
+// vertex doesn't exist.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func LongestPathViaCondensation[I Id, C Cost, V any, E any](g *Graph[I, C, V, E], start I, end I) (C, error) {
+	var longest C
+	startVertex, err := g.GetVertexById(start)
+	if err != nil {
+		return longest, err
+	}
+	endVertex, err := g.GetVertexById(end)
+	if err != nil {
+		return longest, err
+	}
+
+	scc := FindStronglyConnectedComponents(g)
+	componentCount := scc.GetComponentCount()
+	members := make([][]int, componentCount)
+	for i := range g.vertices {
+		componentId := scc.componentIds[i]
+		members[componentId] = append(members[componentId], i)
+	}
+
+	distance := make([]C, componentCount)
+	reached := make([]bool, componentCount)
+	startComponent := scc.componentIds[startVertex.GetCustomDataIndex()]
+	endComponent := scc.componentIds[endVertex.GetCustomDataIndex()]
+	reached[startComponent] = true
+
+	// Tarjan's algorithm numbers the components in reverse topological order
+	for componentId := startComponent; componentId >= 0; componentId-- {
+		if !reached[componentId] {
+			continue
+		}
+		for _, vertexIdx := range members[componentId] {
+			for _, edge := range g.vertices[vertexIdx].edges {
+				targetComponent := scc.componentIds[edge.targetVertex.GetCustomDataIndex()]
+				if targetComponent == componentId {
+					continue // Internal edges are free
+				}
+				tentative := distance[componentId] + edge.cost
+				if !reached[targetComponent] || tentative > distance[targetComponent] {
+					reached[targetComponent] = true
+					distance[targetComponent] = tentative
+				}
+			}
+		}
+	}
+
+	if !reached[endComponent] {
+		return longest, ErrNoPath
+	}
+	return distance[endComponent], nil
+}
+
+// CriticalPath applies the critical path method to a task graph, where vertices are
+// tasks and an edge from A to B means that A has to be finished before B can start.
+// The duration of every task is extracted from its vertex data with the durationOf
+// callback, the edge costs are ignored. The earliest finish time of every task is
+// computed over the topological order, and the critical chain is the sequence of
+// tasks ending with the latest finish, which determines the total project duration.
+// Returns the critical chain from its first task to the last one and the total duration.
+// Returns nil and zero if the graph is empty or contains a cycle.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) CriticalPath(durationOf func(V) C) ([]I, C) {
+	var total C
+	order, ok := g.topologicalOrder()
+	if !ok || len(order) == 0 {
+		return nil, total
+	}
+
+	// The start time of a task is the latest finish time among its prerequisites
+	startTime := make([]C, len(g.vertices))
+	finishTime := make([]C, len(g.vertices))
+	previous := make([]int, len(g.vertices))
+	for i := range previous {
+		previous[i] = -1
+	}
+	lastIdx := order[0]
+	for _, vertexIdx := range order {
+		finishTime[vertexIdx] = startTime[vertexIdx] + durationOf(g.customVertexData[vertexIdx])
+		if finishTime[vertexIdx] > finishTime[lastIdx] {
+			lastIdx = vertexIdx
+		}
+		for _, edge := range g.vertices[vertexIdx].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if previous[targetIdx] < 0 || finishTime[vertexIdx] > startTime[targetIdx] {
+				startTime[targetIdx] = finishTime[vertexIdx]
+				previous[targetIdx] = vertexIdx
+			}
+		}
+	}
+
+	var chain []I
+	for vertexIdx := lastIdx; vertexIdx >= 0; vertexIdx = previous[vertexIdx] {
+		chain = append(chain, g.vertices[vertexIdx].id)
+	}
+	reversePath(chain)
+
+	return chain, finishTime[lastIdx]
+}
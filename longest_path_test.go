@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLongestPathViaCondensation(t *testing.T) {
+	t.Run("Cycle in the middle", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 3, "edge1-2")
+		// Cycle 2 -> 3 -> 4 -> 2 would make the longest walk infinite
+		builder.AddEdge(2, 3, 10, "edge2-3")
+		builder.AddEdge(3, 4, 10, "edge3-4")
+		builder.AddEdge(4, 2, 10, "edge4-2")
+		builder.AddEdge(3, 5, 4, "edge3-5")
+		builder.AddEdge(4, 5, 7, "edge4-5")
+		builder.AddEdge(1, 5, 1, "edge1-5")
+
+		graph := builder.BuildDirected()
+		longest, err := LongestPathViaCondensation(graph, 1, 5)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// 1 -> {2, 3, 4} costs 3, the best exit {2, 3, 4} -> 5 costs 7
+		if longest != 10 {
+			t.Errorf("Expected longest path cost 10, got %d", longest)
+		}
+	})
+
+	t.Run("DAG gives the plain longest path", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(2, 4, 1, "edge2-4")
+		builder.AddEdge(1, 3, 5, "edge1-3")
+		builder.AddEdge(3, 4, 5, "edge3-4")
+		builder.AddEdge(1, 4, 8, "edge1-4")
+
+		graph := builder.BuildDirected()
+		longest, err := LongestPathViaCondensation(graph, 1, 4)
+
+		if err != nil || longest != 10 {
+			t.Errorf("Expected longest path cost 10 and no error, got %d, %v", longest, err)
+		}
+	})
+
+	t.Run("Start and end in the same component", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 5, "edge1-2")
+		builder.AddEdge(2, 1, 5, "edge2-1")
+
+		graph := builder.BuildDirected()
+		longest, err := LongestPathViaCondensation(graph, 1, 2)
+
+		if err != nil || longest != 0 {
+			t.Errorf("Expected cost 0 and no error, got %d, %v", longest, err)
+		}
+	})
+
+	t.Run("Unreachable end", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 5, "edge1-2")
+		builder.AddEdge(3, 2, 5, "edge3-2")
+
+		graph := builder.BuildDirected()
+		_, err := LongestPathViaCondensation(graph, 1, 3)
+
+		if !errors.Is(err, ErrNoPath) {
+			t.Errorf("Expected ErrNoPath, got %v", err)
+		}
+	})
+
+	t.Run("Non-existent vertices", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 5, "edge1-2")
+
+		graph := builder.BuildDirected()
+
+		if _, err := LongestPathViaCondensation(graph, 999, 2); err == nil {
+			t.Error("Expected error for non-existent start vertex")
+		}
+	})
+}
+
+func TestCriticalPath(t *testing.T) {
+	taskDuration := func(task Task) int { return task.Duration }
+
+	t.Run("Task dependency chain", func(t *testing.T) {
+		graph := buildTaskDependencyGraph()
+		chain, total := graph.CriticalPath(taskDuration)
+
+		if !slicesEqual(chain, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected critical chain [1 2 3 4], got %v", chain)
+		}
+		if total != 20 { // 5 + 10 + 3 + 2
+			t.Errorf("Expected total duration 20, got %d", total)
+		}
+
+		sum := 0
+		for _, id := range chain {
+			vertex, _ := graph.GetVertexById(id)
+			task, _ := graph.GetVertexData(vertex)
+			sum += task.Duration
+		}
+		if sum != total {
+			t.Errorf("Expected chain durations to sum up to %d, got %d", total, sum)
+		}
+	})
+
+	t.Run("Parallel tasks", func(t *testing.T) {
+		builder := &Builder[int, int, Task, Dependency]{}
+		builder.AddVertex(1, Task{Name: "Design", Duration: 5})
+		builder.AddVertex(2, Task{Name: "Backend", Duration: 10})
+		builder.AddVertex(3, Task{Name: "Frontend", Duration: 7})
+		builder.AddVertex(4, Task{Name: "Deploy", Duration: 2})
+		builder.AddEdge(1, 2, 0, Dependency{Type: "Blocks"})
+		builder.AddEdge(1, 3, 0, Dependency{Type: "Blocks"})
+		builder.AddEdge(2, 4, 0, Dependency{Type: "Blocks"})
+		builder.AddEdge(3, 4, 0, Dependency{Type: "Blocks"})
+
+		graph := builder.BuildDirected()
+		chain, total := graph.CriticalPath(taskDuration)
+
+		if !slicesEqual(chain, []int{1, 2, 4}) || total != 17 {
+			t.Errorf("Expected critical chain [1 2 4] of 17, got %v of %d", chain, total)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		builder := &Builder[int, int, Task, Dependency]{}
+		builder.AddVertex(1, Task{Name: "A", Duration: 1})
+		builder.AddVertex(2, Task{Name: "B", Duration: 1})
+		builder.AddEdge(1, 2, 0, Dependency{Type: "Blocks"})
+		builder.AddEdge(2, 1, 0, Dependency{Type: "Blocks"})
+
+		graph := builder.BuildDirected()
+		chain, total := graph.CriticalPath(taskDuration)
+
+		if chain != nil || total != 0 {
+			t.Errorf("Expected nil chain and zero duration, got %v of %d", chain, total)
+		}
+	})
+}
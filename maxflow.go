@@ -0,0 +1,342 @@
+package graph
+
+import "errors"
+
+// The MaxFlow algorithm Use-Case (aka Command) object.
+// The edge costs of the graph are interpreted as capacities. Since the graph is
+// immutable, the residual graph (capacity and residual arrays) is built once by
+// NewMaxFlow and reused between calls, so the algorithm is not thread-safe.
+// You need a separate instance of the algorithm for each thread, but the graph
+// itself can be shared safely.
+type MaxFlow[I Id, C Cost, V any, E any] struct {
+	graph *Graph[I, C, V, E]
+	// Residual arcs: every edge of the graph yields a forward arc at an even index
+	// and a reverse arc right after it, so the reverse of arc k is arc k^1.
+	arcTarget   []int // Target vertex index of each arc
+	arcCapacity []C   // Capacity of each arc (zero for reverse arcs)
+	// Remaining capacity of each arc. Pushing flow along an arc moves it to the reverse
+	// arc, so no value ever goes negative, which matters for unsigned cost types.
+	arcResidual []C
+	// Indexes of the arcs leaving each vertex, indexed by the vertex's GetCustomDataIndex()
+	vertexArcs [][]int
+	// Reusable BFS state
+	parentArc []int
+	queue     []int
+	// Reusable Dinic's state: the BFS level of each vertex (-1 if unreachable or a dead
+	// end) and the position of the next arc to try in its vertexArcs
+	level   []int
+	nextArc []int
+	path    []int
+}
+
+// Creates a new MaxFlow instance for the given graph, building the residual graph.
+// Self-loops are ignored, since they can't carry any flow between distinct vertices.
+// This function is thread-safe and can be called concurrently as long as the
+// graph doesn't change.
+func NewMaxFlow[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *MaxFlow[I, C, V, E] {
+	vertexCount := len(graph.vertices)
+	arcCount := 2 * graph.edgeCount
+	mf := &MaxFlow[I, C, V, E]{
+		graph:       graph,
+		arcTarget:   make([]int, 0, arcCount),
+		arcCapacity: make([]C, 0, arcCount),
+		arcResidual: make([]C, 0, arcCount),
+		vertexArcs:  make([][]int, vertexCount),
+		parentArc:   make([]int, vertexCount),
+		queue:       make([]int, 0, vertexCount),
+		level:       make([]int, vertexCount),
+		nextArc:     make([]int, vertexCount),
+	}
+	for i := range graph.vertices {
+		for _, edge := range graph.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if targetIdx != i {
+				mf.addArcPair(i, targetIdx, edge.cost)
+			}
+		}
+	}
+	return mf
+}
+
+// addArcPair adds a forward arc with the given capacity and its zero-capacity reverse arc.
+func (mf *MaxFlow[I, C, V, E]) addArcPair(originIdx int, targetIdx int, capacity C) {
+	mf.vertexArcs[originIdx] = append(mf.vertexArcs[originIdx], len(mf.arcTarget))
+	mf.arcTarget = append(mf.arcTarget, targetIdx)
+	mf.arcCapacity = append(mf.arcCapacity, capacity)
+	mf.arcResidual = append(mf.arcResidual, capacity)
+	mf.vertexArcs[targetIdx] = append(mf.vertexArcs[targetIdx], len(mf.arcTarget))
+	mf.arcTarget = append(mf.arcTarget, originIdx)
+	mf.arcCapacity = append(mf.arcCapacity, 0)
+	mf.arcResidual = append(mf.arcResidual, 0)
+}
+
+// resolveTerminals looks up the source and sink vertices and resets the flow.
+// Returns an error if either vertex doesn't exist or they are the same vertex.
+func (mf *MaxFlow[I, C, V, E]) resolveTerminals(source I, sink I) (int, int, error) {
+	sourceVertex, err := mf.graph.GetVertexById(source)
+	if err != nil {
+		return 0, 0, err
+	}
+	sinkVertex, err := mf.graph.GetVertexById(sink)
+	if err != nil {
+		return 0, 0, err
+	}
+	if source == sink {
+		return 0, 0, errors.New("source and sink must be different vertices")
+	}
+	copy(mf.arcResidual, mf.arcCapacity)
+	return sourceVertex.GetCustomDataIndex(), sinkVertex.GetCustomDataIndex(), nil
+}
+
+// MaxFlow computes the maximum flow from the source to the sink using the
+// Edmonds-Karp algorithm (shortest augmenting paths found with BFS).
+// Capacities must be non-negative.
+// Returns the max flow value, or an error if either vertex doesn't exist or
+// the source and the sink are the same vertex.
+// The flow itself is kept until the next call and can be inspected with FlowOnEdge.
+// Time complexity: O(V * E^2) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (mf *MaxFlow[I, C, V, E]) MaxFlow(source I, sink I) (C, error) {
+	sourceIdx, sinkIdx, err := mf.resolveTerminals(source, sink)
+	if err != nil {
+		return 0, err
+	}
+
+	return mf.augment(sourceIdx, sinkIdx), nil
+}
+
+// augment pushes flow along the shortest augmenting paths until there are none left.
+// Returns the total flow pushed from the source to the sink.
+func (mf *MaxFlow[I, C, V, E]) augment(sourceIdx int, sinkIdx int) C {
+	var total C
+	for mf.findAugmentingPath(sourceIdx, sinkIdx) {
+		// Find the bottleneck along the path
+		bottleneck := mf.arcResidual[mf.parentArc[sinkIdx]]
+		for idx := sinkIdx; idx != sourceIdx; idx = mf.arcTarget[mf.parentArc[idx]^1] {
+			arc := mf.parentArc[idx]
+			if mf.arcResidual[arc] < bottleneck {
+				bottleneck = mf.arcResidual[arc]
+			}
+		}
+		// Push the flow along the path
+		for idx := sinkIdx; idx != sourceIdx; idx = mf.arcTarget[mf.parentArc[idx]^1] {
+			arc := mf.parentArc[idx]
+			mf.arcResidual[arc] -= bottleneck
+			mf.arcResidual[arc^1] += bottleneck
+		}
+		total += bottleneck
+	}
+
+	return total
+}
+
+// findAugmentingPath searches for the shortest path from the source to the sink
+// in the residual graph using BFS, recording the arc leading to each vertex.
+// Returns true if the sink is reachable.
+func (mf *MaxFlow[I, C, V, E]) findAugmentingPath(sourceIdx int, sinkIdx int) bool {
+	for i := range mf.parentArc {
+		mf.parentArc[i] = -1
+	}
+	queue := append(mf.queue[:0], sourceIdx)
+	defer func() { mf.queue = queue[:0] }()
+
+	for head := 0; head < len(queue); head++ {
+		currentIdx := queue[head]
+		for _, arc := range mf.vertexArcs[currentIdx] {
+			targetIdx := mf.arcTarget[arc]
+			if targetIdx == sourceIdx || mf.parentArc[targetIdx] >= 0 || mf.arcResidual[arc] <= 0 {
+				continue
+			}
+			mf.parentArc[targetIdx] = arc
+			if targetIdx == sinkIdx {
+				return true
+			}
+			queue = append(queue, targetIdx)
+		}
+	}
+	return false
+}
+
+// DinicMaxFlow computes the maximum flow from the source to the sink using Dinic's
+// algorithm, which is faster than Edmonds-Karp on larger and denser networks: each
+// phase builds the level graph of the shortest residual paths with a BFS and saturates
+// it with a blocking flow, so there are at most V phases.
+// It shares the residual graph with MaxFlow, so the results are interchangeable,
+// e.g. FlowOnEdge reports the flow found by the last call of either method.
+// Capacities must be non-negative.
+// Returns the max flow value, or an error if either vertex doesn't exist or
+// the source and the sink are the same vertex.
+// Time complexity: O(V^2 * E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (mf *MaxFlow[I, C, V, E]) DinicMaxFlow(source I, sink I) (C, error) {
+	sourceIdx, sinkIdx, err := mf.resolveTerminals(source, sink)
+	if err != nil {
+		return 0, err
+	}
+
+	var total C
+	for mf.buildLevelGraph(sourceIdx, sinkIdx) {
+		for i := range mf.nextArc {
+			mf.nextArc[i] = 0
+		}
+		for {
+			pushed := mf.pushBlockingPath(sourceIdx, sinkIdx)
+			if pushed == 0 {
+				break
+			}
+			total += pushed
+		}
+	}
+
+	return total, nil
+}
+
+// buildLevelGraph assigns every vertex its BFS distance from the source in the residual
+// graph, or -1 if it's unreachable. Returns true if the sink is reachable.
+func (mf *MaxFlow[I, C, V, E]) buildLevelGraph(sourceIdx int, sinkIdx int) bool {
+	for i := range mf.level {
+		mf.level[i] = -1
+	}
+	mf.level[sourceIdx] = 0
+	queue := append(mf.queue[:0], sourceIdx)
+	defer func() { mf.queue = queue[:0] }()
+
+	for head := 0; head < len(queue); head++ {
+		currentIdx := queue[head]
+		for _, arc := range mf.vertexArcs[currentIdx] {
+			targetIdx := mf.arcTarget[arc]
+			if mf.level[targetIdx] >= 0 || mf.arcResidual[arc] <= 0 {
+				continue
+			}
+			mf.level[targetIdx] = mf.level[currentIdx] + 1
+			queue = append(queue, targetIdx)
+		}
+	}
+	return mf.level[sinkIdx] >= 0
+}
+
+// pushBlockingPath finds a path from the source to the sink along the level graph with
+// an iterative DFS and pushes the bottleneck flow along it. The arcs that can't lead to
+// the sink anymore are skipped for the rest of the phase thanks to the nextArc pointers.
+// Returns the pushed flow, or zero if the flow in the level graph is blocking already.
+func (mf *MaxFlow[I, C, V, E]) pushBlockingPath(sourceIdx int, sinkIdx int) C {
+	path := mf.path[:0]
+	defer func() { mf.path = path[:0] }()
+
+	currentIdx := sourceIdx
+	for currentIdx != sinkIdx {
+		arcs := mf.vertexArcs[currentIdx]
+		for mf.nextArc[currentIdx] < len(arcs) {
+			arc := arcs[mf.nextArc[currentIdx]]
+			targetIdx := mf.arcTarget[arc]
+			if mf.arcResidual[arc] > 0 && mf.level[targetIdx] == mf.level[currentIdx]+1 {
+				break
+			}
+			mf.nextArc[currentIdx]++
+		}
+		if mf.nextArc[currentIdx] < len(arcs) {
+			arc := arcs[mf.nextArc[currentIdx]]
+			path = append(path, arc)
+			currentIdx = mf.arcTarget[arc]
+			continue
+		}
+
+		// Dead end: retreat and skip the arc leading here
+		if currentIdx == sourceIdx {
+			return 0
+		}
+		mf.level[currentIdx] = -1
+		arc := path[len(path)-1]
+		path = path[:len(path)-1]
+		currentIdx = mf.arcTarget[arc^1]
+		mf.nextArc[currentIdx]++
+	}
+
+	bottleneck := mf.arcResidual[path[0]]
+	for _, arc := range path {
+		if mf.arcResidual[arc] < bottleneck {
+			bottleneck = mf.arcResidual[arc]
+		}
+	}
+	for _, arc := range path {
+		mf.arcResidual[arc] -= bottleneck
+		mf.arcResidual[arc^1] += bottleneck
+	}
+	return bottleneck
+}
+
+// MinCut finds a minimum s-t cut, i.e. the set of edges with the minimum total
+// capacity whose removal disconnects the sink from the source.
+// It computes the max flow first, then splits the vertices into those reachable from
+// the source in the residual graph and the rest. The edges crossing the partition
+// are saturated and their total capacity equals the max flow (max-flow min-cut theorem).
+// Returns the crossing edges and the cut capacity, or nil and zero if either vertex
+// doesn't exist or the source and the sink are the same vertex.
+// Time complexity: O(V * E^2) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (mf *MaxFlow[I, C, V, E]) MinCut(source I, sink I) ([]EdgeDto[I, C, E], C) {
+	var capacity C
+	if _, err := mf.MaxFlow(source, sink); err != nil {
+		return nil, capacity
+	}
+
+	// With the max flow in place, the BFS fails to reach the sink, leaving the
+	// parent arcs set exactly for the vertices reachable from the source
+	sourceVertex, _ := mf.graph.GetVertexById(source)
+	sinkVertex, _ := mf.graph.GetVertexById(sink)
+	sourceIdx := sourceVertex.GetCustomDataIndex()
+	mf.findAugmentingPath(sourceIdx, sinkVertex.GetCustomDataIndex())
+	reachable := func(idx int) bool {
+		return idx == sourceIdx || mf.parentArc[idx] >= 0
+	}
+
+	g := mf.graph
+	var cut []EdgeDto[I, C, E]
+	for i := range g.vertices {
+		if !reachable(i) {
+			continue
+		}
+		for j := range g.vertices[i].edges {
+			edge := &g.vertices[i].edges[j]
+			if reachable(edge.targetVertex.GetCustomDataIndex()) {
+				continue
+			}
+			cut = append(cut, &BasicEdgeDto[I, C, E]{
+				Origin: g.vertices[i].id,
+				Target: edge.targetVertex.id,
+				Cost:   edge.cost,
+				Data:   g.customEdgeData[edge.customDataIndex],
+			})
+			capacity += edge.cost
+		}
+	}
+	return cut, capacity
+}
+
+// FlowOnEdge returns the flow assigned to the edges going from one vertex to another
+// by the last computation. Flows of parallel edges are summed up.
+// Returns zero if the vertices don't exist or aren't connected.
+// Time complexity: O(D) where D is the degree of the origin vertex.
+func (mf *MaxFlow[I, C, V, E]) FlowOnEdge(from I, to I) C {
+	var flow C
+	fromVertex, err := mf.graph.GetVertexById(from)
+	if err != nil {
+		return flow
+	}
+	toVertex, err := mf.graph.GetVertexById(to)
+	if err != nil {
+		return flow
+	}
+	toIdx := toVertex.GetCustomDataIndex()
+	for _, arc := range mf.vertexArcs[fromVertex.GetCustomDataIndex()] {
+		// Only forward arcs (even indexes) correspond to the graph's edges, and the flow
+		// on them is the part of the capacity used up
+		if arc%2 == 0 && mf.arcTarget[arc] == toIdx {
+			flow += mf.arcCapacity[arc] - mf.arcResidual[arc]
+		}
+	}
+	return flow
+}
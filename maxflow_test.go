@@ -0,0 +1,348 @@
+package graph
+
+import (
+	"testing"
+)
+
+// buildClassicFlowNetwork builds the textbook (CLRS) flow network with max flow 23.
+func buildClassicFlowNetwork() *Graph[string, int, string, string] {
+	builder := &Builder[string, int, string, string]{}
+	builder.AddEdge("s", "v1", 16, "")
+	builder.AddEdge("s", "v2", 13, "")
+	builder.AddEdge("v2", "v1", 4, "")
+	builder.AddEdge("v1", "v3", 12, "")
+	builder.AddEdge("v3", "v2", 9, "")
+	builder.AddEdge("v2", "v4", 14, "")
+	builder.AddEdge("v4", "v3", 7, "")
+	builder.AddEdge("v3", "t", 20, "")
+	builder.AddEdge("v4", "t", 4, "")
+	return builder.BuildDirected()
+}
+
+// buildUnsignedFlowNetwork builds a network with max flow 2 and unsigned capacities
+// where the first (shortest) augmenting path s-a-b-t has to be partially cancelled
+// through the reverse arc b->a to reach the max flow.
+func buildUnsignedFlowNetwork() *Graph[string, uint, string, string] {
+	builder := &Builder[string, uint, string, string]{}
+	builder.AddEdge("s", "a", 1, "")
+	builder.AddEdge("a", "b", 1, "")
+	builder.AddEdge("b", "t", 1, "")
+	builder.AddEdge("a", "x", 1, "")
+	builder.AddEdge("x", "y", 1, "")
+	builder.AddEdge("y", "t", 1, "")
+	builder.AddEdge("s", "p", 1, "")
+	builder.AddEdge("p", "q", 1, "")
+	builder.AddEdge("q", "b", 1, "")
+	return builder.BuildDirected()
+}
+
+func TestNewMaxFlow(t *testing.T) {
+	graph := buildClassicFlowNetwork()
+	mf := NewMaxFlow(graph)
+
+	if mf == nil {
+		t.Fatal("Expected MaxFlow instance, got nil")
+	}
+
+	if len(mf.arcTarget) != 2*graph.GetEdgeCount() {
+		t.Errorf("Expected %d residual arcs, got %d", 2*graph.GetEdgeCount(), len(mf.arcTarget))
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	t.Run("Classic 4-node network", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 3, "")
+		builder.AddEdge(1, 3, 2, "")
+		builder.AddEdge(2, 3, 5, "")
+		builder.AddEdge(2, 4, 2, "")
+		builder.AddEdge(3, 4, 3, "")
+
+		graph := builder.BuildDirected()
+		mf := NewMaxFlow(graph)
+
+		flow, err := mf.MaxFlow(1, 4)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if flow != 5 {
+			t.Errorf("Expected max flow 5, got %d", flow)
+		}
+
+		if mf.FlowOnEdge(2, 4) != 2 || mf.FlowOnEdge(3, 4) != 3 {
+			t.Errorf("Expected sink edges to be saturated, got %d and %d", mf.FlowOnEdge(2, 4), mf.FlowOnEdge(3, 4))
+		}
+	})
+
+	t.Run("Textbook network", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		flow, err := mf.MaxFlow("s", "t")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if flow != 23 {
+			t.Errorf("Expected max flow 23, got %d", flow)
+		}
+
+		// Flow conservation at every inner vertex
+		for _, id := range []string{"v1", "v2", "v3", "v4"} {
+			var balance int
+			graph.VisitEdges(func(vertex *Vertex[string, int], edge *Edge[string, int]) {
+				if vertex.GetId() == id {
+					balance -= mf.FlowOnEdge(id, edge.GetTargetVertex().GetId())
+				}
+				if edge.GetTargetVertex().GetId() == id {
+					balance += mf.FlowOnEdge(vertex.GetId(), id)
+				}
+			})
+			if balance != 0 {
+				t.Errorf("Expected flow conservation at %s, got balance %d", id, balance)
+			}
+		}
+	})
+
+	t.Run("Repeated calls give the same result", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		first, _ := mf.MaxFlow("s", "t")
+		second, _ := mf.MaxFlow("s", "t")
+
+		if first != second {
+			t.Errorf("Expected repeated calls to return %d, got %d", first, second)
+		}
+	})
+
+	t.Run("Unreachable sink", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "")
+		builder.AddEdge(3, 2, 5.0, "")
+
+		graph := builder.BuildDirected()
+		mf := NewMaxFlow(graph)
+
+		flow, err := mf.MaxFlow(1, 3)
+		if err != nil || flow != 0 {
+			t.Errorf("Expected zero flow and no error, got %f, %v", flow, err)
+		}
+	})
+
+	t.Run("Unsigned capacities", func(t *testing.T) {
+		graph := buildUnsignedFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		flow, err := mf.MaxFlow("s", "t")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if flow != 2 {
+			t.Errorf("Expected max flow 2, got %d", flow)
+		}
+
+		// The flow pushed along a-b is cancelled by the second augmenting path
+		if mf.FlowOnEdge("a", "b") != 0 || mf.FlowOnEdge("a", "x") != 1 || mf.FlowOnEdge("q", "b") != 1 {
+			t.Errorf("Expected the flow to be rerouted around a-b, got a-b %d, a-x %d, q-b %d",
+				mf.FlowOnEdge("a", "b"), mf.FlowOnEdge("a", "x"), mf.FlowOnEdge("q", "b"))
+		}
+	})
+
+	t.Run("Invalid terminals", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		if _, err := mf.MaxFlow("x", "t"); err == nil {
+			t.Error("Expected error for non-existent source")
+		}
+
+		if _, err := mf.MaxFlow("s", "x"); err == nil {
+			t.Error("Expected error for non-existent sink")
+		}
+
+		if _, err := mf.MaxFlow("s", "s"); err == nil {
+			t.Error("Expected error for identical source and sink")
+		}
+	})
+}
+
+func TestMinCut(t *testing.T) {
+	t.Run("Cut value equals max flow", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		flow, _ := mf.MaxFlow("s", "t")
+		cut, capacity := mf.MinCut("s", "t")
+
+		if capacity != flow {
+			t.Errorf("Expected cut capacity %d to equal max flow %d", capacity, flow)
+		}
+
+		// The unique minimum cut separates {s, v1, v2, v4} from {v3, t}
+		expected := map[[2]string]bool{{"v1", "v3"}: true, {"v4", "v3"}: true, {"v4", "t"}: true}
+		if len(cut) != len(expected) {
+			t.Fatalf("Expected %d cut edges, got %d", len(expected), len(cut))
+		}
+		for _, edge := range cut {
+			if !expected[[2]string{edge.GetOrigin(), edge.GetTarget()}] {
+				t.Errorf("Unexpected cut edge %s -> %s", edge.GetOrigin(), edge.GetTarget())
+			}
+		}
+	})
+
+	t.Run("Bottleneck link", func(t *testing.T) {
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddBiEdge("A", "B", 10.0, "local")
+		builder.AddBiEdge("A", "C", 10.0, "local")
+		builder.AddEdge("C", "D", 2.5, "bridge")
+		builder.AddBiEdge("D", "E", 10.0, "local")
+
+		graph := builder.BuildDirected()
+		mf := NewMaxFlow(graph)
+
+		cut, capacity := mf.MinCut("A", "E")
+
+		if capacity != 2.5 {
+			t.Errorf("Expected cut capacity 2.5, got %f", capacity)
+		}
+
+		if len(cut) != 1 || cut[0].GetData() != "bridge" {
+			t.Errorf("Expected the bridge to be the only cut edge, got %v", cut)
+		}
+	})
+
+	t.Run("Unsigned capacities", func(t *testing.T) {
+		graph := buildUnsignedFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		flow, _ := mf.MaxFlow("s", "t")
+		cut, capacity := mf.MinCut("s", "t")
+
+		if capacity != flow {
+			t.Errorf("Expected cut capacity %d to equal max flow %d", capacity, flow)
+		}
+
+		// Both edges leaving the source are saturated, so the source is cut off
+		expected := map[[2]string]bool{{"s", "a"}: true, {"s", "p"}: true}
+		if len(cut) != len(expected) {
+			t.Fatalf("Expected %d cut edges, got %d", len(expected), len(cut))
+		}
+		for _, edge := range cut {
+			if !expected[[2]string{edge.GetOrigin(), edge.GetTarget()}] {
+				t.Errorf("Unexpected cut edge %s -> %s", edge.GetOrigin(), edge.GetTarget())
+			}
+		}
+	})
+
+	t.Run("Invalid terminals", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		if cut, capacity := mf.MinCut("s", "x"); cut != nil || capacity != 0 {
+			t.Errorf("Expected nil cut for non-existent sink, got %v, %d", cut, capacity)
+		}
+	})
+}
+
+func TestDinicMaxFlow(t *testing.T) {
+	t.Run("Same flow as Edmonds-Karp", func(t *testing.T) {
+		classic := &Builder[int, int, string, string]{}
+		classic.AddEdge(1, 2, 3, "")
+		classic.AddEdge(1, 3, 2, "")
+		classic.AddEdge(2, 3, 5, "")
+		classic.AddEdge(2, 4, 2, "")
+		classic.AddEdge(3, 4, 3, "")
+		unreachable := &Builder[int, int, string, string]{}
+		unreachable.AddEdge(1, 2, 5, "")
+		unreachable.AddEdge(3, 2, 5, "")
+		bottleneck := &Builder[int, int, string, string]{}
+		bottleneck.AddBiEdge(1, 2, 10, "local")
+		bottleneck.AddBiEdge(1, 3, 10, "local")
+		bottleneck.AddEdge(3, 4, 2, "bridge")
+		bottleneck.AddBiEdge(4, 5, 10, "local")
+
+		cases := []struct {
+			name         string
+			graph        *Graph[int, int, string, string]
+			source, sink int
+		}{
+			{"Classic 4-node network", classic.BuildDirected(), 1, 4},
+			{"Unreachable sink", unreachable.BuildDirected(), 1, 3},
+			{"Bottleneck link", bottleneck.BuildDirected(), 1, 5},
+		}
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(30, 0.2, seed,
+				func(origin int, target int) int { return (origin*7+target*13)%10 + 1 },
+				func(id int) string { return "" },
+				func(origin int, target int) string { return "" },
+			)
+			cases = append(cases, struct {
+				name         string
+				graph        *Graph[int, int, string, string]
+				source, sink int
+			}{"Random", graph, 0, 29})
+		}
+
+		for _, c := range cases {
+			mf := NewMaxFlow(c.graph)
+			expected, _ := mf.MaxFlow(c.source, c.sink)
+			flow, err := mf.DinicMaxFlow(c.source, c.sink)
+			if err != nil || flow != expected {
+				t.Errorf("%s: expected max flow %d, got %d (%v)", c.name, expected, flow, err)
+			}
+		}
+	})
+
+	t.Run("Textbook network", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		flow, err := mf.DinicMaxFlow("s", "t")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if flow != 23 {
+			t.Errorf("Expected max flow 23, got %d", flow)
+		}
+
+		// The flow into the sink adds up to the max flow
+		if sinkFlow := mf.FlowOnEdge("v3", "t") + mf.FlowOnEdge("v4", "t"); sinkFlow != 23 {
+			t.Errorf("Expected flow 23 into the sink, got %d", sinkFlow)
+		}
+	})
+
+	t.Run("Unsigned capacities", func(t *testing.T) {
+		graph := buildUnsignedFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		flow, err := mf.DinicMaxFlow("s", "t")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if flow != 2 {
+			t.Errorf("Expected max flow 2, got %d", flow)
+		}
+
+		if sinkFlow := mf.FlowOnEdge("b", "t") + mf.FlowOnEdge("y", "t"); sinkFlow != 2 {
+			t.Errorf("Expected flow 2 into the sink, got %d", sinkFlow)
+		}
+	})
+
+	t.Run("Invalid terminals", func(t *testing.T) {
+		graph := buildClassicFlowNetwork()
+		mf := NewMaxFlow(graph)
+
+		if _, err := mf.DinicMaxFlow("x", "t"); err == nil {
+			t.Error("Expected error for non-existent source")
+		}
+
+		if _, err := mf.DinicMaxFlow("s", "s"); err == nil {
+			t.Error("Expected error for identical source and sink")
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package graph
+
+// MaximalChains returns all maximal paths of a directed acyclic graph, i.e. every
+// path starting at a source (a vertex without incoming edges) and ending at a sink
+// (a vertex without outgoing edges). An isolated vertex forms a chain on its own.
+// This reveals the end-to-end sequences of e.g. a task dependency graph.
+// Cyclic graphs have no well-defined set of maximal chains, so nil is returned for them.
+// Note that the number of chains may grow exponentially with the size of the graph.
+// Time complexity: O(V + E + P) where V is the number of vertices, E is the number of
+// edges and P is the total length of the returned chains.
+// Space complexity: O(V) besides the result.
+func (g *Graph[I, C, V, E]) MaximalChains() [][]I {
+	if _, ok := g.topologicalOrder(); !ok {
+		return nil
+	}
+
+	hasIncoming := make([]bool, len(g.vertices))
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			hasIncoming[edge.targetVertex.GetCustomDataIndex()] = true
+		}
+	}
+
+	type stackItem struct {
+		vertexIdx int
+		edgeIdx   int // Index of the next outgoing edge to follow
+	}
+	var chains [][]I
+	var stack []stackItem
+	var path []I
+
+	for source := range g.vertices {
+		if hasIncoming[source] {
+			continue
+		}
+		stack = append(stack[:0], stackItem{vertexIdx: source})
+		path = append(path[:0], g.vertices[source].id)
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			edges := g.vertices[top.vertexIdx].edges
+
+			// A sink completes a chain
+			if len(edges) == 0 {
+				chain := make([]I, len(path))
+				copy(chain, path)
+				chains = append(chains, chain)
+			}
+
+			if top.edgeIdx == len(edges) {
+				stack = stack[:len(stack)-1]
+				path = path[:len(path)-1]
+				continue
+			}
+
+			next := edges[top.edgeIdx].targetVertex
+			top.edgeIdx++
+			stack = append(stack, stackItem{vertexIdx: next.GetCustomDataIndex()})
+			path = append(path, next.id)
+		}
+	}
+
+	return chains
+}
@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMaximalChains(t *testing.T) {
+	t.Run("Task graph forms one chain", func(t *testing.T) {
+		graph := buildTaskDependencyGraph()
+		chains := graph.MaximalChains()
+
+		if len(chains) != 1 {
+			t.Fatalf("Expected 1 chain, got %d: %v", len(chains), chains)
+		}
+
+		expected := []int{1, 2, 3, 4}
+		if !slicesEqual(chains[0], expected) {
+			t.Errorf("Expected chain %v, got %v", expected, chains[0])
+		}
+	})
+
+	t.Run("Branching DAG", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(5, 3, 1.0, "edge5-3")
+		builder.AddVertex(6, "isolated")
+
+		graph := builder.BuildDirected()
+		chains := graph.MaximalChains()
+
+		expected := map[string]bool{"[1 2 4]": true, "[1 3 4]": true, "[5 3 4]": true, "[6]": true}
+		if len(chains) != len(expected) {
+			t.Fatalf("Expected %d chains, got %d: %v", len(expected), len(chains), chains)
+		}
+		for _, chain := range chains {
+			if !expected[fmt.Sprint(chain)] {
+				t.Errorf("Unexpected chain %v", chain)
+			}
+		}
+	})
+
+	t.Run("Cyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+
+		graph := builder.BuildDirected()
+
+		if chains := graph.MaximalChains(); chains != nil {
+			t.Errorf("Expected nil for cyclic graph, got %v", chains)
+		}
+	})
+}
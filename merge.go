@@ -0,0 +1,43 @@
+package graph
+
+// Merge combines the graph with another one into a new graph, e.g. to assemble a road
+// network from regional pieces. Neither of the source graphs is modified.
+// Vertices with the same ID are unified. The resolve callback is called for every vertex
+// ID present in both graphs, since the custom data can't be compared in general, and
+// decides which data the unified vertex gets (it receives the data from this graph
+// first). If resolve is nil, the data from the other graph wins.
+// All edges from both graphs are retained, so an edge present in both graphs
+// becomes a pair of parallel edges in the result.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges of both graphs.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges of both graphs.
+func (g *Graph[I, C, V, E]) Merge(other *Graph[I, C, V, E], resolve func(id I, existing V, incoming V) V) *Graph[I, C, V, E] {
+	builder := &Builder[I, C, V, E]{}
+
+	for i := range g.vertices {
+		id := g.vertices[i].id
+		data := g.customVertexData[i]
+		if otherIdx, exists := other.idToIndex[id]; exists {
+			if resolve != nil {
+				data = resolve(id, data, other.customVertexData[otherIdx])
+			} else {
+				data = other.customVertexData[otherIdx]
+			}
+		}
+		builder.AddVertex(id, data)
+	}
+	for i := range other.vertices {
+		if _, exists := g.idToIndex[other.vertices[i].id]; !exists {
+			builder.AddVertex(other.vertices[i].id, other.customVertexData[i])
+		}
+	}
+
+	for _, source := range [2]*Graph[I, C, V, E]{g, other} {
+		for i := range source.vertices {
+			for _, edge := range source.vertices[i].edges {
+				builder.AddEdge(source.vertices[i].id, edge.targetVertex.id, edge.cost, source.customEdgeData[edge.customDataIndex])
+			}
+		}
+	}
+
+	return builder.BuildDirected()
+}
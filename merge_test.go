@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("Disjoint chains", func(t *testing.T) {
+		first := &Builder[int, float64, string, string]{}
+		first.AddEdge(1, 2, 1.0, "edge1-2")
+		first.AddEdge(2, 3, 1.0, "edge2-3")
+		second := &Builder[int, float64, string, string]{}
+		second.AddEdge(4, 5, 1.0, "edge4-5")
+		second.AddEdge(5, 6, 1.0, "edge5-6")
+
+		merged := first.BuildDirected().Merge(second.BuildDirected(), nil)
+
+		if merged.GetVertexCount() != 6 || merged.GetEdgeCount() != 4 {
+			t.Errorf("Expected 6 vertices and 4 edges, got %d and %d", merged.GetVertexCount(), merged.GetEdgeCount())
+		}
+		if count := FindConnectedComponents(merged).GetComponentCount(); count != 2 {
+			t.Errorf("Expected 2 components, got %d", count)
+		}
+	})
+
+	t.Run("Overlapping graphs sharing a vertex", func(t *testing.T) {
+		first := &Builder[int, float64, string, string]{}
+		first.AddVertex(2, "west")
+		first.AddEdge(1, 2, 1.0, "edge1-2")
+		second := &Builder[int, float64, string, string]{}
+		second.AddVertex(2, "east")
+		second.AddEdge(2, 3, 1.0, "edge2-3")
+
+		firstGraph := first.BuildDirected()
+		secondGraph := second.BuildDirected()
+		merged := firstGraph.Merge(secondGraph, func(id int, existing string, incoming string) string {
+			return existing + "+" + incoming
+		})
+
+		if merged.GetVertexCount() != 3 || merged.GetEdgeCount() != 2 {
+			t.Errorf("Expected 3 vertices and 2 edges, got %d and %d", merged.GetVertexCount(), merged.GetEdgeCount())
+		}
+
+		vertex, _ := merged.GetVertexById(2)
+		data, _ := merged.GetVertexData(vertex)
+		if *data != "west+east" {
+			t.Errorf("Expected resolved data 'west+east', got '%s'", *data)
+		}
+
+		path := NewDijkstra(merged).FindShortestPath(1, 3)
+		if !slicesEqual(path, []int{1, 2, 3}) {
+			t.Errorf("Expected path [1 2 3] across the shared vertex, got %v", path)
+		}
+
+		// The source graphs stay intact
+		if firstGraph.GetVertexCount() != 2 || secondGraph.GetVertexCount() != 2 {
+			t.Error("Expected the source graphs to be unchanged")
+		}
+	})
+
+	t.Run("Other data wins without resolver", func(t *testing.T) {
+		first := &Builder[int, float64, string, string]{}
+		first.AddVertex(1, "old")
+		second := &Builder[int, float64, string, string]{}
+		second.AddVertex(1, "new")
+
+		merged := first.BuildDirected().Merge(second.BuildDirected(), nil)
+
+		vertex, _ := merged.GetVertexById(1)
+		data, _ := merged.GetVertexData(vertex)
+		if *data != "new" {
+			t.Errorf("Expected data 'new', got '%s'", *data)
+		}
+	})
+
+	t.Run("Duplicate edges become parallel", func(t *testing.T) {
+		first := &Builder[int, float64, string, string]{}
+		first.AddEdge(1, 2, 1.0, "first")
+		second := &Builder[int, float64, string, string]{}
+		second.AddEdge(1, 2, 2.0, "second")
+
+		merged := first.BuildDirected().Merge(second.BuildDirected(), nil)
+
+		vertex, _ := merged.GetVertexById(1)
+		if len(vertex.GetEdges()) != 2 {
+			t.Errorf("Expected 2 parallel edges, got %d", len(vertex.GetEdges()))
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// mermaidEdgeKey identifies the edges connecting the same ordered pair of vertices with
+// the same label, which are interchangeable in a Mermaid flowchart.
+type mermaidEdgeKey struct {
+	originIdx int
+	targetIdx int
+	label     string
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart ("graph LR" block), so it can be
+// embedded directly in Markdown documents and GitHub issues. The vertices are labeled
+// by the vertexLabel callback and the edges by the edgeLabel one. If a callback is nil,
+// the vertex IDs or the edge costs are used as the labels.
+// A pair of opposite edges with the same label is collapsed into a single undirected
+// link ("---"), so the bidirectional connections don't clutter the chart.
+// The vertices get synthetic node names (n0, n1, ...) since the IDs may contain
+// characters that aren't allowed there, and the quotes in the labels are escaped.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(E) where E is the number of edges.
+func (g *Graph[I, C, V, E]) WriteMermaid(
+	w io.Writer,
+	vertexLabel func(id I, data V) string,
+	edgeLabel func(cost C, data E) string,
+) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "graph LR")
+	for i := range g.vertices {
+		label := fmt.Sprint(g.vertices[i].id)
+		if vertexLabel != nil {
+			label = vertexLabel(g.vertices[i].id, g.customVertexData[g.vertices[i].customDataIndex])
+		}
+		fmt.Fprintf(out, "    n%d[\"%s\"]\n", i, escapeMermaidLabel(label))
+	}
+
+	// The number of edges of every key that haven't been written yet, and how many of
+	// them have already been written as a part of an undirected link
+	remaining := make(map[mermaidEdgeKey]int, g.edgeCount)
+	collapsed := make(map[mermaidEdgeKey]int)
+	labels := make([]string, 0, g.edgeCount)
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			label := formatCost(edge.cost)
+			if edgeLabel != nil {
+				label = edgeLabel(edge.cost, g.customEdgeData[edge.customDataIndex])
+			}
+			labels = append(labels, label)
+			remaining[mermaidEdgeKey{i, edge.targetVertex.GetCustomDataIndex(), label}]++
+		}
+	}
+
+	k := 0
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			key := mermaidEdgeKey{i, targetIdx, labels[k]}
+			k++
+			remaining[key]--
+			if collapsed[key] > 0 {
+				collapsed[key]--
+				continue
+			}
+			link := "-->"
+			reverseKey := mermaidEdgeKey{targetIdx, i, key.label}
+			if targetIdx != i && remaining[reverseKey]-collapsed[reverseKey] > 0 {
+				collapsed[reverseKey]++
+				link = "---"
+			}
+			fmt.Fprintf(out, "    n%d %s|\"%s\"| n%d\n", i, link, escapeMermaidLabel(key.label), targetIdx)
+		}
+	}
+
+	return out.Flush()
+}
+
+// escapeMermaidLabel replaces the characters that would terminate a quoted Mermaid label.
+func escapeMermaidLabel(label string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", "<br>").Replace(label)
+}
@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestGraphWriteMermaid(t *testing.T) {
+	countLines := func(output string, marker string) int {
+		count := 0
+		for _, line := range strings.Split(output, "\n") {
+			if strings.Contains(line, marker) {
+				count++
+			}
+		}
+		return count
+	}
+
+	t.Run("Nodes and edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 10, "edge1-2")
+		builder.AddEdge(2, 3, 5, "edge2-3")
+		builder.AddEdge(3, 1, 1, "edge3-1")
+		builder.AddEdge(1, 4, 2, "edge1-4")
+		builder.AddVertex(5, "vertex5")
+
+		graph := builder.BuildDirected()
+		var buf bytes.Buffer
+		if err := graph.WriteMermaid(&buf, nil, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		output := buf.String()
+
+		if !strings.HasPrefix(output, "graph LR\n") {
+			t.Errorf("Expected the graph LR header, got %q", output)
+		}
+		if nodes := countLines(output, "[\""); nodes != graph.GetVertexCount() {
+			t.Errorf("Expected %d nodes, got %d", graph.GetVertexCount(), nodes)
+		}
+		if edges := countLines(output, "-->"); edges != graph.GetEdgeCount() {
+			t.Errorf("Expected %d edges, got %d", graph.GetEdgeCount(), edges)
+		}
+		if !strings.Contains(output, "n0 -->|\"10.0\"| n1") {
+			t.Errorf("Expected the edge n0 -> n1 labeled with its cost, got %q", output)
+		}
+	})
+
+	t.Run("Bidirectional edges are collapsed", func(t *testing.T) {
+		builder := &Builder[string, int, string, string]{}
+		builder.AddBiEdge("a", "b", 1, "road")
+		builder.AddEdge("b", "c", 1, "one-way")
+		builder.AddEdge("c", "b", 1, "other-way")
+
+		graph := builder.BuildDirected()
+		var buf bytes.Buffer
+		err := graph.WriteMermaid(&buf,
+			func(id string, data string) string { return "City " + id },
+			func(cost int, data string) string { return data },
+		)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		output := buf.String()
+
+		if nodes := countLines(output, "[\"City "); nodes != 3 {
+			t.Errorf("Expected 3 nodes, got %d", nodes)
+		}
+		if links := countLines(output, "---"); links != 1 {
+			t.Errorf("Expected 1 undirected link, got %d in %q", links, output)
+		}
+		if edges := countLines(output, "-->"); edges != 2 {
+			t.Errorf("Expected 2 directed edges, got %d in %q", edges, output)
+		}
+	})
+
+	t.Run("Quotes are escaped", func(t *testing.T) {
+		builder := &Builder[string, int, string, string]{}
+		builder.AddEdge(`say "hi"`, "b", 1, "edge")
+
+		graph := builder.BuildDirected()
+		var buf bytes.Buffer
+		if err := graph.WriteMermaid(&buf, nil, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !strings.Contains(buf.String(), `n0["say #quot;hi#quot;"]`) {
+			t.Errorf("Expected escaped quotes, got %q", buf.String())
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package graph
+
+// MinimumWeightCycle finds the directed cycle with the smallest total edge cost.
+// Returns the cycle as a slice of vertex IDs (the first vertex is not repeated at the end),
+// its total cost, and true. Returns nil, zero cost and false if the graph is acyclic.
+// For every edge u->v the shortest path from v back to u is found with Dijkstra's
+// algorithm, so edge costs must be non-negative.
+// Time complexity: O(E * E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+func MinimumWeightCycle[I Id, C Cost, V any, E any](g *Graph[I, C, V, E]) ([]I, C, bool) {
+	dijkstra := NewDijkstra(g)
+	var bestCycle []I
+	var bestCost C
+	found := false
+
+	for i := range g.vertices {
+		origin := &g.vertices[i]
+		for j := range origin.edges {
+			edge := &origin.edges[j]
+			target := edge.targetVertex
+
+			// A self-loop is a cycle on its own
+			if target == origin {
+				if !found || edge.cost < bestCost {
+					bestCycle = []I{origin.id}
+					bestCost = edge.cost
+					found = true
+				}
+				continue
+			}
+
+			// Find the cheapest way back from the target to the origin
+			path := dijkstra.FindShortestPath(target.id, origin.id)
+			if path == nil {
+				continue
+			}
+			cycleCost := saturatingAdd(dijkstra.vertexData[origin.GetCustomDataIndex()].cost, edge.cost)
+			if !found || cycleCost < bestCost {
+				// The path is [target, ..., origin], so the cycle starts with the origin
+				bestCycle = make([]I, len(path))
+				bestCycle[0] = origin.id
+				copy(bestCycle[1:], path[:len(path)-1])
+				bestCost = cycleCost
+				found = true
+			}
+		}
+	}
+
+	return bestCycle, bestCost, found
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestMinimumWeightCycle(t *testing.T) {
+	t.Run("Cheaper of two cycles is returned", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Expensive cycle: 1 -> 2 -> 3 -> 1 (cost 30)
+		builder.AddEdge(1, 2, 10.0, "edge1-2")
+		builder.AddEdge(2, 3, 10.0, "edge2-3")
+		builder.AddEdge(3, 1, 10.0, "edge3-1")
+		// Cheap cycle: 4 -> 5 -> 4 (cost 3)
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(5, 4, 2.0, "edge5-4")
+
+		graph := builder.BuildDirected()
+		cycle, cost, ok := MinimumWeightCycle(graph)
+
+		if !ok {
+			t.Fatal("Expected a cycle to be found")
+		}
+
+		if cost != 3.0 {
+			t.Errorf("Expected cycle cost 3.0, got %f", cost)
+		}
+
+		if len(cycle) != 2 {
+			t.Fatalf("Expected cycle of 2 vertices, got %v", cycle)
+		}
+
+		if !(cycle[0] == 4 && cycle[1] == 5) && !(cycle[0] == 5 && cycle[1] == 4) {
+			t.Errorf("Expected cycle over vertices 4 and 5, got %v", cycle)
+		}
+	})
+
+	t.Run("Cycle vertices are connected in order", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+		builder.AddEdge(1, 5, 1.0, "edge1-5")
+
+		graph := builder.BuildDirected()
+		cycle, cost, ok := MinimumWeightCycle(graph)
+
+		if !ok {
+			t.Fatal("Expected a cycle to be found")
+		}
+
+		if cost != 4.0 {
+			t.Errorf("Expected cycle cost 4.0, got %f", cost)
+		}
+
+		for i := range cycle {
+			from, _ := graph.GetVertexById(cycle[i])
+			to := cycle[(i+1)%len(cycle)]
+			connected := false
+			for _, edge := range from.GetEdges() {
+				if edge.GetTargetVertex().GetId() == to {
+					connected = true
+				}
+			}
+			if !connected {
+				t.Errorf("Expected edge %d -> %d in cycle %v", cycle[i], to, cycle)
+			}
+		}
+	})
+
+	t.Run("Self-loop", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(3, 3, 0.5, "edge3-3")
+
+		graph := builder.BuildDirected()
+		cycle, cost, ok := MinimumWeightCycle(graph)
+
+		if !ok {
+			t.Fatal("Expected a cycle to be found")
+		}
+
+		if cost != 0.5 || len(cycle) != 1 || cycle[0] != 3 {
+			t.Errorf("Expected self-loop [3] with cost 0.5, got %v with cost %f", cycle, cost)
+		}
+	})
+
+	t.Run("Small integer costs don't overflow", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		builder.AddEdge(1, 2, 200, "edge1-2")
+		builder.AddEdge(2, 1, 100, "edge2-1")
+		builder.AddEdge(3, 4, 60, "edge3-4")
+		builder.AddEdge(4, 3, 60, "edge4-3")
+
+		graph := builder.BuildDirected()
+		cycle, cost, ok := MinimumWeightCycle(graph)
+
+		if !ok || cost != 120 || !slicesEqual(cycle, []int{3, 4}) {
+			t.Errorf("Expected cycle [3 4] with cost 120, got %v with cost %d", cycle, cost)
+		}
+	})
+
+	t.Run("Acyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+		cycle, cost, ok := MinimumWeightCycle(graph)
+
+		if ok || cycle != nil || cost != 0 {
+			t.Errorf("Expected no cycle, got %v with cost %f", cycle, cost)
+		}
+	})
+}
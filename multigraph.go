@@ -0,0 +1,119 @@
+package graph
+
+// ParallelEdgeCount reports an ordered vertex pair connected by more than one edge.
+type ParallelEdgeCount[I Id] struct {
+	Origin I   // Origin vertex identifier
+	Target I   // Target vertex identifier
+	Count  int // Number of edges going from the origin to the target
+}
+
+// ParallelEdges lists every ordered vertex pair connected by more than one edge
+// together with the number of such edges, which helps to audit imported multigraphs.
+// The edges are directed, so 1->2 and 2->1 are different pairs, and self-loops
+// are reported like any other edge. The pairs are ordered by the origin vertex
+// index and then by the first occurrence of the target in the origin's edges.
+// Returns an empty slice for a simple graph.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) ParallelEdges() []ParallelEdgeCount[I] {
+	result := []ParallelEdgeCount[I]{}
+	// The number of edges to each target of the current origin, reset after each origin
+	counts := make([]int, len(g.vertices))
+	for i := range g.vertices {
+		edges := g.vertices[i].edges
+		for _, edge := range edges {
+			counts[edge.targetVertex.GetCustomDataIndex()]++
+		}
+		for _, edge := range edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if counts[targetIdx] > 1 {
+				result = append(result, ParallelEdgeCount[I]{
+					Origin: g.vertices[i].id,
+					Target: edge.targetVertex.id,
+					Count:  counts[targetIdx],
+				})
+			}
+			counts[targetIdx] = 0 // Report each pair only once
+		}
+	}
+	return result
+}
+
+// HasSelfLoops reports whether any edge of the graph starts and ends at the same vertex.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) HasSelfLoops() bool {
+	return g.SomeEdges(func(vertex *Vertex[I, C], edge *Edge[I, C]) bool {
+		return edge.targetVertex == vertex
+	})
+}
+
+// SelfLoops returns the IDs of the vertices that have an edge to themselves, each
+// listed once in the order the vertices were added to the graph.
+// Returns an empty slice if there are no self-loops.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) SelfLoops() []I {
+	result := []I{}
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			if edge.targetVertex.GetCustomDataIndex() == i {
+				result = append(result, g.vertices[i].id)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// HasParallelEdges reports whether any ordered vertex pair is connected by more than one edge.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) HasParallelEdges() bool {
+	// The origin index each target was last seen from
+	seenFrom := make([]int, len(g.vertices))
+	for i := range seenFrom {
+		seenFrom[i] = -1
+	}
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if seenFrom[targetIdx] == i {
+				return true
+			}
+			seenFrom[targetIdx] = i
+		}
+	}
+	return false
+}
+
+// ParallelEdgeDtos returns every edge that connects the same ordered vertex pair as
+// another edge, including the first one of each group, so that the duplicates can be
+// inspected one by one. See ParallelEdges for the per-pair counts.
+// The edges are ordered by the origin vertex index and then as they are stored.
+// Returns an empty slice for a simple graph.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices, excluding the result.
+func (g *Graph[I, C, V, E]) ParallelEdgeDtos() []EdgeDto[I, C, E] {
+	result := []EdgeDto[I, C, E]{}
+	counts := make([]int, len(g.vertices))
+	for i := range g.vertices {
+		edges := g.vertices[i].edges
+		for _, edge := range edges {
+			counts[edge.targetVertex.GetCustomDataIndex()]++
+		}
+		for _, edge := range edges {
+			if counts[edge.targetVertex.GetCustomDataIndex()] > 1 {
+				result = append(result, &BasicEdgeDto[I, C, E]{
+					Origin: g.vertices[i].id,
+					Target: edge.targetVertex.id,
+					Cost:   edge.cost,
+					Data:   g.customEdgeData[edge.customDataIndex],
+				})
+			}
+		}
+		for _, edge := range edges {
+			counts[edge.targetVertex.GetCustomDataIndex()] = 0
+		}
+	}
+	return result
+}
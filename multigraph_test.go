@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestParallelEdges(t *testing.T) {
+	t.Run("Duplicated edge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "first")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(1, 2, 2.0, "second")
+		builder.AddEdge(2, 1, 1.0, "reverse")
+
+		graph := builder.BuildDirected()
+		parallel := graph.ParallelEdges()
+
+		expected := []ParallelEdgeCount[int]{{Origin: 1, Target: 2, Count: 2}}
+		if len(parallel) != len(expected) || parallel[0] != expected[0] {
+			t.Errorf("Expected %v, got %v", expected, parallel)
+		}
+	})
+
+	t.Run("Several pairs and self-loops", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 3, 1.0, "loop")
+		builder.AddEdge(3, 3, 1.0, "loop")
+
+		graph := builder.BuildDirected()
+		parallel := graph.ParallelEdges()
+
+		counts := make(map[EdgeKey[int]]int)
+		for _, pair := range parallel {
+			counts[EdgeKey[int]{Origin: pair.Origin, Target: pair.Target}] = pair.Count
+		}
+		if len(parallel) != 2 || counts[EdgeKey[int]{Origin: 1, Target: 2}] != 3 || counts[EdgeKey[int]{Origin: 3, Target: 3}] != 2 {
+			t.Errorf("Expected {1 2 3} and {3 3 2}, got %v", parallel)
+		}
+	})
+
+	t.Run("Simple graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		if parallel := graph.ParallelEdges(); len(parallel) != 0 {
+			t.Errorf("Expected an empty slice, got %v", parallel)
+		}
+	})
+}
+
+func TestSelfLoops(t *testing.T) {
+	t.Run("Graph with self-loops", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 2, 1.0, "loop")
+		builder.AddEdge(2, 2, 2.0, "loop")
+		builder.AddEdge(3, 3, 1.0, "loop")
+
+		graph := builder.BuildDirected()
+		if !graph.HasSelfLoops() {
+			t.Errorf("Expected self-loops")
+		}
+		expected := []int{2, 3}
+		if loops := graph.SelfLoops(); !slicesEqual(loops, expected) {
+			t.Errorf("Expected %v, got %v", expected, loops)
+		}
+	})
+
+	t.Run("Graph without self-loops", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if graph.HasSelfLoops() {
+			t.Errorf("Expected no self-loops")
+		}
+		if loops := graph.SelfLoops(); len(loops) != 0 {
+			t.Errorf("Expected an empty slice, got %v", loops)
+		}
+	})
+}
+
+func TestParallelEdgeDtos(t *testing.T) {
+	t.Run("Graph with a self-loop and a duplicated edge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "first")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(1, 2, 2.0, "second")
+		builder.AddEdge(3, 3, 1.0, "loop")
+
+		graph := builder.BuildDirected()
+		if !graph.HasParallelEdges() {
+			t.Errorf("Expected parallel edges")
+		}
+		dtos := graph.ParallelEdgeDtos()
+		if len(dtos) != 2 {
+			t.Fatalf("Expected 2 edges, got %d", len(dtos))
+		}
+		for i, data := range []string{"first", "second"} {
+			if dtos[i].GetOrigin() != 1 || dtos[i].GetTarget() != 2 || dtos[i].GetData() != data {
+				t.Errorf("Expected edge 1->2 with data %s, got %v", data, dtos[i])
+			}
+		}
+		if loops := graph.SelfLoops(); !slicesEqual(loops, []int{3}) {
+			t.Errorf("Expected [3], got %v", loops)
+		}
+	})
+
+	t.Run("Simple graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+		if graph.HasParallelEdges() {
+			t.Errorf("Expected no parallel edges")
+		}
+		if dtos := graph.ParallelEdgeDtos(); len(dtos) != 0 {
+			t.Errorf("Expected an empty slice, got %v", dtos)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package graph
+
+import "math"
+
+// PageRank computes the PageRank of every vertex with the power iteration method.
+// Every edge is a link, so parallel edges count multiple times and edge costs are
+// ignored. The rank of dangling vertices (without outgoing edges) is spread evenly
+// over all the vertices. The damping factor is typically 0.85.
+// The iteration stops when the L1 distance between two consecutive rank vectors
+// drops below tol, or after maxIter iterations.
+// Returns the ranks, which sum up to one.
+// Time complexity: O(maxIter * (V + E)) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func PageRank[I Id, C Cost, V any, E any](g *Graph[I, C, V, E], damping float64, maxIter int, tol float64) map[I]float64 {
+	ranks, _ := PageRankWithResidual(g, damping, maxIter, tol)
+	return ranks
+}
+
+// PageRankWithResidual computes the PageRank like PageRank does, but also returns
+// the L1 residual (the distance between two consecutive rank vectors) after each
+// iteration, which helps to plot the convergence and tune the parameters.
+// The residuals decrease at least by the damping factor on every iteration.
+// Time complexity: O(maxIter * (V + E)) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + maxIter) where V is the number of vertices.
+func PageRankWithResidual[I Id, C Cost, V any, E any](g *Graph[I, C, V, E], damping float64, maxIter int, tol float64) (map[I]float64, []float64) {
+	vertexCount := len(g.vertices)
+	result := make(map[I]float64, vertexCount)
+	if vertexCount == 0 {
+		return result, nil
+	}
+
+	rank := make([]float64, vertexCount)
+	next := make([]float64, vertexCount)
+	for i := range rank {
+		rank[i] = 1 / float64(vertexCount)
+	}
+
+	var residuals []float64
+	for iteration := 0; iteration < maxIter; iteration++ {
+		danglingRank := 0.0
+		for i := range next {
+			next[i] = 0
+		}
+		for i := range g.vertices {
+			edges := g.vertices[i].edges
+			if len(edges) == 0 {
+				danglingRank += rank[i]
+				continue
+			}
+			share := rank[i] / float64(len(edges))
+			for _, edge := range edges {
+				next[edge.targetVertex.GetCustomDataIndex()] += share
+			}
+		}
+
+		base := (1-damping)/float64(vertexCount) + damping*danglingRank/float64(vertexCount)
+		residual := 0.0
+		for i := range next {
+			next[i] = base + damping*next[i]
+			residual += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		residuals = append(residuals, residual)
+		if residual < tol {
+			break
+		}
+	}
+
+	for i := range g.vertices {
+		result[g.vertices[i].id] = rank[i]
+	}
+	return result, residuals
+}
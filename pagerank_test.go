@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPageRank(t *testing.T) {
+	t.Run("Symmetric cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		ranks := PageRank(graph, 0.85, 100, 1e-10)
+
+		for id := 1; id <= 3; id++ {
+			if math.Abs(ranks[id]-1.0/3.0) > 1e-9 {
+				t.Errorf("Expected rank 1/3 for vertex %d, got %f", id, ranks[id])
+			}
+		}
+	})
+
+	t.Run("Hub gets the highest rank", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(4, 1, 1.0, "edge4-1")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		ranks := PageRank(graph, 0.85, 100, 1e-10)
+
+		sum := 0.0
+		for id, rank := range ranks {
+			sum += rank
+			if id != 1 && rank >= ranks[1] {
+				t.Errorf("Expected vertex %d to rank lower than the hub, got %f >= %f", id, rank, ranks[1])
+			}
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("Expected ranks to sum up to 1, got %f", sum)
+		}
+	})
+
+	t.Run("Dangling vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+		ranks := PageRank(graph, 0.85, 100, 1e-10)
+
+		sum := ranks[1] + ranks[2] + ranks[3]
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("Expected ranks to sum up to 1, got %f", sum)
+		}
+		if math.Abs(ranks[2]-ranks[3]) > 1e-12 || ranks[2] <= ranks[1] {
+			t.Errorf("Expected equal ranks of 2 and 3 above 1, got %v", ranks)
+		}
+	})
+}
+
+func TestPageRankWithResidual(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 1.0, "edge1-2")
+	builder.AddEdge(1, 3, 1.0, "edge1-3")
+	builder.AddEdge(2, 3, 1.0, "edge2-3")
+	builder.AddEdge(3, 1, 1.0, "edge3-1")
+	builder.AddEdge(4, 3, 1.0, "edge4-3")
+	builder.AddEdge(4, 5, 1.0, "edge4-5")
+
+	graph := builder.BuildDirected()
+
+	t.Run("Residuals decrease toward the tolerance", func(t *testing.T) {
+		const tol = 1e-8
+		ranks, residuals := PageRankWithResidual(graph, 0.85, 1000, tol)
+
+		if len(residuals) == 0 {
+			t.Fatal("Expected residuals")
+		}
+		for i := 1; i < len(residuals); i++ {
+			if residuals[i] > residuals[i-1] {
+				t.Errorf("Expected non-increasing residuals, got %g after %g at %d", residuals[i], residuals[i-1], i)
+			}
+		}
+		if last := residuals[len(residuals)-1]; last >= tol {
+			t.Errorf("Expected the last residual below %g, got %g", tol, last)
+		}
+		if len(ranks) != 5 {
+			t.Errorf("Expected 5 ranks, got %d", len(ranks))
+		}
+	})
+
+	t.Run("Limited by the iteration count", func(t *testing.T) {
+		_, residuals := PageRankWithResidual(graph, 0.85, 3, 1e-12)
+
+		if len(residuals) != 3 {
+			t.Errorf("Expected 3 residuals, got %d", len(residuals))
+		}
+	})
+}
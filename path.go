@@ -0,0 +1,38 @@
+package graph
+
+import "errors"
+
+// ErrNoPath is returned by path algorithms when the end vertex isn't reachable
+// from the start vertex.
+var ErrNoPath = errors.New("no path found")
+
+// ReconstructPath builds the path from start to end out of a predecessor map, which
+// associates each reached vertex ID with the ID of the vertex preceding it on the path.
+// Walks from end back to start via the predecessors and returns the path in
+// start-to-end order. Returns nil if end is unreachable (absent from the map) or the
+// predecessor chain doesn't lead to start.
+// Time complexity: O(P) where P is the length of the path.
+func ReconstructPath[I Id](preds map[I]I, start I, end I) []I {
+	path := []I{end}
+	current := end
+	for current != start {
+		previous, ok := preds[current]
+		// The second condition protects against cyclic predecessor chains
+		if !ok || len(path) > len(preds) {
+			return nil
+		}
+		path = append(path, previous)
+		current = previous
+	}
+	reversePath(path)
+	return path
+}
+
+// reversePath reverses the order of the vertex IDs of a path in place.
+// Path reconstruction walks from the end back to the start, so the collected
+// IDs have to be reversed to get start-to-end order.
+func reversePath[I Id](path []I) {
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+}
@@ -0,0 +1,77 @@
+package graph
+
+// MinimumPathCover finds a minimum set of vertex-disjoint directed paths that
+// together cover every vertex of a directed acyclic graph.
+// The problem is reduced to a maximum bipartite matching between the "out" and
+// "in" copies of the vertices, where every matched edge u->v glues u and v into
+// one path, so the number of paths equals V minus the matching size.
+// Returns the paths as slices of vertex IDs, or ErrCycleDetected if the graph has a cycle.
+// Time complexity: O(V * E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func MinimumPathCover[I Id, C Cost, V any, E any](g *Graph[I, C, V, E]) ([][]I, error) {
+	if _, ok := g.topologicalOrder(); !ok {
+		return nil, ErrCycleDetected
+	}
+
+	vertexCount := len(g.vertices)
+	next := make([]int, vertexCount)     // Matched successor of each vertex, or -1
+	previous := make([]int, vertexCount) // Matched predecessor of each vertex, or -1
+	for i := range next {
+		next[i] = -1
+		previous[i] = -1
+	}
+
+	// Kuhn's augmenting path search, implemented iteratively
+	type stackItem struct {
+		vertexIdx int
+		edgeIdx   int // Index of the next outgoing edge to try
+		via       int // Successor through which the search descended
+	}
+	visited := make([]int, vertexCount) // Stamp of the last search visiting each "in" copy
+	for i := range visited {
+		visited[i] = -1
+	}
+	var stack []stackItem
+	for root := range g.vertices {
+		stack = append(stack[:0], stackItem{vertexIdx: root})
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			edges := g.vertices[top.vertexIdx].edges
+			if top.edgeIdx == len(edges) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			targetIdx := edges[top.edgeIdx].targetVertex.GetCustomDataIndex()
+			top.edgeIdx++
+			if visited[targetIdx] == root {
+				continue
+			}
+			visited[targetIdx] = root
+			top.via = targetIdx
+			if previous[targetIdx] >= 0 {
+				stack = append(stack, stackItem{vertexIdx: previous[targetIdx]})
+				continue
+			}
+			// Free "in" copy found, flip the matching along the search path
+			for _, item := range stack {
+				next[item.vertexIdx] = item.via
+				previous[item.via] = item.vertexIdx
+			}
+			break
+		}
+	}
+
+	// Every vertex without a matched predecessor starts a path
+	var paths [][]I
+	for i := range g.vertices {
+		if previous[i] >= 0 {
+			continue
+		}
+		var path []I
+		for idx := i; idx >= 0; idx = next[idx] {
+			path = append(path, g.vertices[idx].id)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMinimumPathCover(t *testing.T) {
+	// assertValidCover checks that the paths follow edges and cover each vertex exactly once
+	assertValidCover := func(t *testing.T, graph *Graph[int, float64, string, string], paths [][]int) {
+		covered := map[int]int{}
+		for _, path := range paths {
+			for i, id := range path {
+				covered[id]++
+				if i == 0 {
+					continue
+				}
+				from, _ := graph.GetVertexById(path[i-1])
+				connected := false
+				for _, edge := range from.GetEdges() {
+					if edge.GetTargetVertex().GetId() == id {
+						connected = true
+					}
+				}
+				if !connected {
+					t.Errorf("Expected edge %d -> %d in path %v", path[i-1], id, path)
+				}
+			}
+		}
+		if len(covered) != graph.GetVertexCount() {
+			t.Errorf("Expected all %d vertices to be covered, got %d", graph.GetVertexCount(), len(covered))
+		}
+		for id, count := range covered {
+			if count != 1 {
+				t.Errorf("Expected vertex %d to be covered once, got %d", id, count)
+			}
+		}
+	}
+
+	t.Run("Simple DAG", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Two chains joined in the middle: 1 -> 3 -> 4 and 2 -> 3 -> 5
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(3, 5, 1.0, "edge3-5")
+
+		graph := builder.BuildDirected()
+		paths, err := MinimumPathCover(graph)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		// The maximum matching has size 2 (one edge into 3 and one out of it)
+		if len(paths) != graph.GetVertexCount()-2 {
+			t.Errorf("Expected %d paths, got %d: %v", graph.GetVertexCount()-2, len(paths), paths)
+		}
+
+		assertValidCover(t, graph, paths)
+	})
+
+	t.Run("Augmenting path is required", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		paths, err := MinimumPathCover(graph)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(paths) != 1 {
+			t.Errorf("Expected a single Hamiltonian path, got %v", paths)
+		}
+
+		assertValidCover(t, graph, paths)
+	})
+
+	t.Run("Isolated vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "A")
+		builder.AddVertex(2, "B")
+
+		graph := builder.BuildDirected()
+		paths, err := MinimumPathCover(graph)
+
+		if err != nil || len(paths) != 2 {
+			t.Errorf("Expected 2 single-vertex paths and no error, got %v, %v", paths, err)
+		}
+	})
+
+	t.Run("Cyclic graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+
+		graph := builder.BuildDirected()
+		_, err := MinimumPathCover(graph)
+
+		if !errors.Is(err, ErrCycleDetected) {
+			t.Errorf("Expected ErrCycleDetected, got %v", err)
+		}
+	})
+}
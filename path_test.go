@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestReconstructPath(t *testing.T) {
+	t.Run("Known path", func(t *testing.T) {
+		preds := map[int]int{2: 1, 3: 2, 4: 3, 5: 2}
+
+		path := ReconstructPath(preds, 1, 4)
+		expected := []int{1, 2, 3, 4}
+
+		if !slicesEqual(path, expected) {
+			t.Errorf("Expected path %v, got %v", expected, path)
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		path := ReconstructPath(map[int]int{}, 1, 1)
+
+		if !slicesEqual(path, []int{1}) {
+			t.Errorf("Expected path [1], got %v", path)
+		}
+	})
+
+	t.Run("Unreachable end", func(t *testing.T) {
+		preds := map[int]int{2: 1, 3: 2}
+
+		if path := ReconstructPath(preds, 1, 9); path != nil {
+			t.Errorf("Expected nil path, got %v", path)
+		}
+	})
+
+	t.Run("Chain not leading to start", func(t *testing.T) {
+		preds := map[string]string{"B": "A", "C": "B"}
+
+		if path := ReconstructPath(preds, "X", "C"); path != nil {
+			t.Errorf("Expected nil path, got %v", path)
+		}
+	})
+
+	t.Run("Cyclic predecessor chain", func(t *testing.T) {
+		preds := map[int]int{2: 3, 3: 2}
+
+		if path := ReconstructPath(preds, 1, 2); path != nil {
+			t.Errorf("Expected nil path, got %v", path)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package graph
+
+import "math/rand"
+
+// RandomWalk performs a random walk from the start vertex following the outgoing edges
+// for up to the given number of steps, which is the basis of sampling-based graph
+// embeddings. At every step the next edge is chosen uniformly, or with the probability
+// proportional to its cost if weighted is true (the costs must be non-negative then,
+// and a vertex whose edges all cost zero falls back to the uniform choice).
+// The walk stops early at a sink, i.e. a vertex without outgoing edges.
+// The result is deterministic for the same seed and parameters.
+// Returns the visited vertices starting with the start one, so there are at most
+// steps+1 of them, or nil if the start vertex doesn't exist.
+// Time complexity: O(steps * D) where D is the maximum out-degree.
+// Space complexity: O(steps).
+func (g *Graph[I, C, V, E]) RandomWalk(start I, steps int, seed int64, weighted bool) []I {
+	current, err := g.GetVertexById(start)
+	if err != nil {
+		return nil
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	walk := []I{start}
+	for step := 0; step < steps && len(current.edges) > 0; step++ {
+		next := rng.Intn(len(current.edges))
+		if weighted {
+			var total float64
+			for _, edge := range current.edges {
+				total += float64(edge.cost)
+			}
+			if total > 0 {
+				// Find the edge the random point on the cumulative cost scale falls on,
+				// skipping the zero-cost edges which can't be chosen
+				point := rng.Float64() * total
+				for i, edge := range current.edges {
+					if edge.cost <= 0 {
+						continue
+					}
+					next = i
+					point -= float64(edge.cost)
+					if point < 0 {
+						break
+					}
+				}
+			}
+		}
+		current = current.edges[next].targetVertex
+		walk = append(walk, current.id)
+	}
+
+	return walk
+}
@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphRandomWalk(t *testing.T) {
+	hasEdge := func(graph *Graph[int, float64, string, string], origin int, target int) bool {
+		vertex, err := graph.GetVertexById(origin)
+		if err != nil {
+			return false
+		}
+		for _, edge := range vertex.GetEdges() {
+			if edge.GetTargetVertex().GetId() == target {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("Follows the edges", func(t *testing.T) {
+		graph := GenerateRandom(30, 0.2, 1,
+			func(origin int, target int) float64 { return float64(origin + target) },
+			func(id int) string { return "vertex" },
+			func(origin int, target int) string { return "edge" },
+		)
+		for _, weighted := range []bool{false, true} {
+			walk := graph.RandomWalk(0, 100, 42, weighted)
+			if len(walk) == 0 || len(walk) > 101 || walk[0] != 0 {
+				t.Fatalf("Expected a walk from 0 of at most 101 vertices, got %v", walk)
+			}
+			for i := 1; i < len(walk); i++ {
+				if !hasEdge(graph, walk[i-1], walk[i]) {
+					t.Errorf("Expected an edge %d->%d, got none", walk[i-1], walk[i])
+				}
+			}
+		}
+	})
+
+	t.Run("Stops at a sink", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(2, 3, 1, "edge2-3")
+
+		graph := builder.BuildDirected()
+		walk := graph.RandomWalk(1, 10, 0, false)
+
+		if !slicesEqual(walk, []int{1, 2, 3}) {
+			t.Errorf("Expected walk [1 2 3], got %v", walk)
+		}
+	})
+
+	t.Run("Zero steps", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+
+		graph := builder.BuildDirected()
+		walk := graph.RandomWalk(1, 0, 0, false)
+
+		if !slicesEqual(walk, []int{1}) {
+			t.Errorf("Expected walk [1], got %v", walk)
+		}
+	})
+
+	t.Run("Deterministic per seed", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(1, 3, 1, "edge1-3")
+		builder.AddEdge(2, 1, 1, "edge2-1")
+		builder.AddEdge(3, 1, 1, "edge3-1")
+
+		graph := builder.BuildDirected()
+		for seed := int64(0); seed < 10; seed++ {
+			first := graph.RandomWalk(1, 50, seed, false)
+			second := graph.RandomWalk(1, 50, seed, false)
+			if !slicesEqual(first, second) {
+				t.Errorf("Seed %d: expected equal walks, got %v and %v", seed, first, second)
+			}
+		}
+	})
+
+	t.Run("Weighted walk skips zero-cost edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 0, "edge1-2")
+		builder.AddEdge(1, 3, 1, "edge1-3")
+		builder.AddEdge(3, 1, 1, "edge3-1")
+
+		graph := builder.BuildDirected()
+		walk := graph.RandomWalk(1, 100, 7, true)
+
+		if len(walk) != 101 {
+			t.Errorf("Expected 101 vertices, got %d", len(walk))
+		}
+		for _, id := range walk {
+			if id == 2 {
+				t.Errorf("Expected vertex 2 to never be visited, got %v", walk)
+				break
+			}
+		}
+	})
+
+	t.Run("Weighted walk follows the costs", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(1, 3, 9, "edge1-3")
+		builder.AddEdge(2, 1, 1, "edge2-1")
+		builder.AddEdge(3, 1, 1, "edge3-1")
+
+		graph := builder.BuildDirected()
+		walk := graph.RandomWalk(1, 2000, 3, true)
+
+		visits := map[int]int{}
+		for _, id := range walk {
+			visits[id]++
+		}
+		if visits[3] < 5*visits[2] {
+			t.Errorf("Expected vertex 3 to be visited far more often than 2, got %d and %d", visits[3], visits[2])
+		}
+	})
+
+	t.Run("Missing start", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if walk := graph.RandomWalk(5, 10, 0, false); walk != nil {
+			t.Errorf("Expected nil, got %v", walk)
+		}
+	})
+}
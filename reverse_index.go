@@ -0,0 +1,61 @@
+package graph
+
+// predecessor is an incoming edge of a vertex in the reverse index.
+type predecessor[I Id, C Cost] struct {
+	originIdx int         // Index of the vertex the edge goes from
+	edge      *Edge[I, C] // The edge itself
+}
+
+// BuildReverseIndex computes the incoming edges of every vertex, since the graph stores
+// only the outgoing ones, and caches them in the graph for GetPredecessors. It's a no-op
+// if the index has already been built.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// This function is thread-safe: concurrent calls build the index only once, and all of
+// them return after it's built.
+func (g *Graph[I, C, V, E]) BuildReverseIndex() {
+	g.reverseIndexOnce.Do(g.buildReverseIndex)
+}
+
+// buildReverseIndex implements BuildReverseIndex.
+func (g *Graph[I, C, V, E]) buildReverseIndex() {
+	inDegree := make([]int, len(g.vertices))
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			inDegree[edge.targetVertex.GetCustomDataIndex()]++
+		}
+	}
+	predecessors := make([][]predecessor[I, C], len(g.vertices))
+	for i := range predecessors {
+		predecessors[i] = make([]predecessor[I, C], 0, inDegree[i])
+	}
+	for i := range g.vertices {
+		for j := range g.vertices[i].edges {
+			edge := &g.vertices[i].edges[j]
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			predecessors[targetIdx] = append(predecessors[targetIdx], predecessor[I, C]{originIdx: i, edge: edge})
+		}
+	}
+	g.predecessors = predecessors
+}
+
+// GetPredecessors returns the IDs of the vertices having an edge to the given vertex,
+// once per edge, so a vertex connected with parallel edges is repeated. The order
+// follows the vertex indexes and then the order of the edges.
+// The reverse index is built by the first call if BuildReverseIndex hasn't been called.
+// Returns an error if the vertex doesn't exist.
+// Time complexity: O(K) where K is the number of the predecessors, once the index is built.
+// This function is thread-safe and can be called concurrently.
+func (g *Graph[I, C, V, E]) GetPredecessors(id I) ([]I, error) {
+	vertex, err := g.GetVertexById(id)
+	if err != nil {
+		return nil, err
+	}
+	g.BuildReverseIndex()
+	incoming := g.predecessors[vertex.GetCustomDataIndex()]
+	ids := make([]I, len(incoming))
+	for i, p := range incoming {
+		ids[i] = g.vertices[p.originIdx].id
+	}
+	return ids, nil
+}
@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestGraphGetPredecessors(t *testing.T) {
+	t.Run("Inverse of the edge set", func(t *testing.T) {
+		graph := GenerateRandom(30, 0.15, 1,
+			func(origin int, target int) float64 { return 1 },
+			func(id int) string { return "vertex" },
+			func(origin int, target int) string { return "edge" },
+		)
+		graph.BuildReverseIndex()
+
+		expected := make(map[int][]int)
+		graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			target := edge.GetTargetVertex().GetId()
+			expected[target] = append(expected[target], vertex.GetId())
+		})
+
+		for _, id := range graph.GetVertexIds() {
+			predecessors, err := graph.GetPredecessors(id)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			sort.Ints(predecessors)
+			sort.Ints(expected[id])
+			if len(predecessors) != len(expected[id]) || (len(predecessors) > 0 && !slicesEqual(predecessors, expected[id])) {
+				t.Errorf("Vertex %d: expected predecessors %v, got %v", id, expected[id], predecessors)
+			}
+		}
+	})
+
+	t.Run("Built on demand", func(t *testing.T) {
+		builder := &Builder[string, float64, string, string]{}
+		builder.AddEdge("A", "C", 1, "edgeA-C")
+		builder.AddEdge("B", "C", 1, "edgeB-C")
+		builder.AddEdge("B", "C", 2, "parallel")
+		builder.AddEdge("C", "C", 1, "loop")
+
+		graph := builder.BuildDirected()
+		predecessors, err := graph.GetPredecessors("C")
+
+		// The vertex indexes follow the order the vertices are first seen in: A, C, B
+		if err != nil || !slicesEqualString(predecessors, []string{"A", "C", "B", "B"}) {
+			t.Errorf("Expected predecessors [A C B B], got %v (%v)", predecessors, err)
+		}
+		if predecessors, _ := graph.GetPredecessors("A"); len(predecessors) != 0 {
+			t.Errorf("Expected no predecessors, got %v", predecessors)
+		}
+		if _, err := graph.GetPredecessors("X"); err == nil {
+			t.Error("Expected error for non-existent vertex")
+		}
+	})
+
+	t.Run("Concurrent build on demand", func(t *testing.T) {
+		graph := GenerateRandom(100, 0.1, 2,
+			func(origin int, target int) float64 { return 1 },
+			func(id int) string { return "vertex" },
+			func(origin int, target int) string { return "edge" },
+		)
+
+		var wg sync.WaitGroup
+		counts := make([]int, 8)
+		for i := range counts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for _, id := range graph.GetVertexIds() {
+					predecessors, _ := graph.GetPredecessors(id)
+					counts[i] += len(predecessors)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for i, count := range counts {
+			if count != graph.GetEdgeCount() {
+				t.Errorf("Goroutine %d: expected %d predecessors in total, got %d", i, graph.GetEdgeCount(), count)
+			}
+		}
+	})
+}
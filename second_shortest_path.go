@@ -0,0 +1,219 @@
+package graph
+
+import "container/heap"
+
+// rankedPath is a simple path found by the k-shortest paths search together with
+// the accumulated cost at each of its vertices.
+type rankedPath[I Id, C Cost] struct {
+	vertices []*Vertex[I, C]
+	costs    []C
+}
+
+// cost returns the total cost of the path.
+func (p *rankedPath[I, C]) cost() C {
+	return p.costs[len(p.costs)-1]
+}
+
+// hasPrefix reports whether the path starts with the given vertices.
+func (p *rankedPath[I, C]) hasPrefix(prefix []*Vertex[I, C]) bool {
+	if len(p.vertices) < len(prefix) {
+		return false
+	}
+	for i, vertex := range prefix {
+		if p.vertices[i] != vertex {
+			return false
+		}
+	}
+	return true
+}
+
+// equals reports whether both paths visit the same vertices in the same order.
+func (p *rankedPath[I, C]) equals(other *rankedPath[I, C]) bool {
+	return len(p.vertices) == len(other.vertices) && p.hasPrefix(other.vertices)
+}
+
+// Finds the cheapest simple path between two vertices whose cost is strictly greater
+// than the cost of the shortest path, i.e. the next-best route. Paths never repeat
+// a vertex. The paths are enumerated in the order of their cost with Yen's algorithm
+// until one costs more than the shortest path, so alternatives of the same cost as
+// the shortest path are skipped. Edge costs must be non-negative.
+// The Amplifier and the snapshot cost overrides are respected as by FindShortestPath.
+// Returns the path and its total cost, or nil and zero if no such path exists or if
+// StrictNonNegative is set and a negative edge cost is encountered.
+// Time complexity: O(K * V * E log V) where K is the number of paths that cost as much as
+// the shortest one, E is the number of edges and V is the number of vertices.
+// Space complexity: O(K * V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) SecondShortestPath(start I, end I) ([]I, C) {
+	var zero C
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil, zero // Start vertex not found
+	}
+	endVertex, err := d.graph.GetVertexById(end)
+	if err != nil {
+		return nil, zero // End vertex not found
+	}
+
+	blockedVertices := make([]bool, len(d.graph.vertices))
+	blockedEdges := make(map[EdgeKey[int]]bool)
+	shortest, err := d.findSpurPath(startVertex, endVertex, zero, blockedVertices, blockedEdges)
+	if shortest == nil || err != nil {
+		return nil, zero
+	}
+
+	found := []*rankedPath[I, C]{shortest}
+	var candidates []*rankedPath[I, C]
+	for {
+		// Deviate from the last found path at each of its vertices
+		last := found[len(found)-1]
+		for i := 0; i < len(last.vertices)-1; i++ {
+			root := last.vertices[:i+1]
+			for _, path := range found {
+				if path.hasPrefix(root) {
+					blockedEdges[EdgeKey[int]{
+						Origin: root[i].GetCustomDataIndex(),
+						Target: path.vertices[i+1].GetCustomDataIndex(),
+					}] = true
+				}
+			}
+			for _, vertex := range root[:i] {
+				blockedVertices[vertex.GetCustomDataIndex()] = true
+			}
+
+			spur, err := d.findSpurPath(root[i], endVertex, last.costs[i], blockedVertices, blockedEdges)
+			if err != nil {
+				return nil, zero // Negative edge cost
+			}
+			if spur != nil {
+				candidate := &rankedPath[I, C]{
+					vertices: append(append([]*Vertex[I, C]{}, root[:i]...), spur.vertices...),
+					costs:    append(append([]C{}, last.costs[:i]...), spur.costs...),
+				}
+				if !containsRankedPath(candidates, candidate) && !containsRankedPath(found, candidate) {
+					candidates = append(candidates, candidate)
+				}
+			}
+
+			for key := range blockedEdges {
+				delete(blockedEdges, key)
+			}
+			for _, vertex := range root[:i] {
+				blockedVertices[vertex.GetCustomDataIndex()] = false
+			}
+		}
+
+		if len(candidates) == 0 {
+			return nil, zero // No alternative exists
+		}
+		best := 0
+		for i := range candidates {
+			if candidates[i].cost() < candidates[best].cost() {
+				best = i
+			}
+		}
+		next := candidates[best]
+		candidates[best] = candidates[len(candidates)-1]
+		candidates = candidates[:len(candidates)-1]
+
+		if next.cost() > shortest.cost() {
+			path := make([]I, len(next.vertices))
+			for i, vertex := range next.vertices {
+				path[i] = vertex.id
+			}
+			return path, next.cost()
+		}
+		found = append(found, next)
+	}
+}
+
+// containsRankedPath reports whether the list contains a path with the same vertices.
+func containsRankedPath[I Id, C Cost](paths []*rankedPath[I, C], path *rankedPath[I, C]) bool {
+	for _, other := range paths {
+		if other.equals(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// findSpurPath finds the shortest path between two vertices avoiding the blocked
+// vertices and the blocked edges (keyed by the vertex indexes). The accumulated
+// costs of the path start at the given initial cost.
+// Returns nil if no path is found, or ErrNegativeEdgeCost if StrictNonNegative is set
+// and a negative edge cost is encountered.
+func (d *Dijkstra[I, C, V, E]) findSpurPath(
+	startVertex *Vertex[I, C],
+	endVertex *Vertex[I, C],
+	initialCost C,
+	blockedVertices []bool,
+	blockedEdges map[EdgeKey[int]]bool,
+) (*rankedPath[I, C], error) {
+	for i := range d.vertexData {
+		// Blocked vertices are marked visited, so they are never relaxed
+		d.vertexData[i].visited = blockedVertices[i]
+		d.vertexData[i].reached = false
+		d.vertexData[i].previous = nil
+		d.vertexData[i].cost = d.maxCost
+	}
+
+	d.heap.clear()
+	startIdx := startVertex.GetCustomDataIndex()
+	d.vertexData[startIdx].cost = initialCost
+	d.vertexData[startIdx].reached = true
+	heap.Push(d.heap, startVertex)
+
+	for d.heap.Len() > 0 {
+		current := heap.Pop(d.heap).(*Vertex[I, C])
+		currentIdx := current.GetCustomDataIndex()
+		currentData := &d.vertexData[currentIdx]
+		if currentData.visited {
+			continue
+		}
+		currentData.visited = true
+		if current == endVertex {
+			break
+		}
+
+		for _, edge := range current.edges {
+			neighbor := edge.targetVertex
+			neighborIdx := neighbor.GetCustomDataIndex()
+			neighborData := &d.vertexData[neighborIdx]
+			if blockedEdges[EdgeKey[int]{Origin: currentIdx, Target: neighborIdx}] ||
+				(neighborData.visited && !d.StrictNonNegative) {
+				continue
+			}
+
+			edgeCost, enabled, err := d.edgeCost(current, &edge)
+			if err != nil {
+				return nil, err
+			}
+			if !enabled || neighborData.visited {
+				continue
+			}
+
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				neighborData.reached = true
+				neighborData.cost = tentativeDistance
+				neighborData.previous = current
+				d.heap.pushOrFix(neighbor)
+			}
+		}
+	}
+
+	if !d.vertexData[endVertex.GetCustomDataIndex()].reached || blockedVertices[endVertex.GetCustomDataIndex()] {
+		return nil, nil
+	}
+
+	path := &rankedPath[I, C]{}
+	for current := endVertex; current != nil; current = d.vertexData[current.GetCustomDataIndex()].previous {
+		path.vertices = append(path.vertices, current)
+		path.costs = append(path.costs, d.vertexData[current.GetCustomDataIndex()].cost)
+	}
+	for i, j := 0, len(path.vertices)-1; i < j; i, j = i+1, j-1 {
+		path.vertices[i], path.vertices[j] = path.vertices[j], path.vertices[i]
+		path.costs[i], path.costs[j] = path.costs[j], path.costs[i]
+	}
+	return path, nil
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestSecondShortestPath(t *testing.T) {
+	t.Run("Two routes", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		// Two paths: 1->2->4 (cost 3) and 1->3->4 (cost 5)
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(1, 3, 2.0, "1-3")
+		builder.AddEdge(2, 4, 2.0, "2-4")
+		builder.AddEdge(3, 4, 3.0, "3-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.SecondShortestPath(1, 4)
+		expected := []int{1, 3, 4}
+		if !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+		if cost != 5.0 {
+			t.Errorf("Expected cost 5, got %v", cost)
+		}
+	})
+
+	t.Run("Alternatives of the same cost are skipped", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 5, 2.0, "2-5")
+		builder.AddEdge(1, 3, 2.0, "1-3")
+		builder.AddEdge(3, 5, 1.0, "3-5")
+		builder.AddEdge(1, 4, 1.0, "1-4")
+		builder.AddEdge(4, 5, 3.0, "4-5")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.SecondShortestPath(1, 5)
+		expected := []int{1, 4, 5}
+		if !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+		if cost != 4.0 {
+			t.Errorf("Expected cost 4, got %v", cost)
+		}
+	})
+
+	t.Run("Deviation in the middle of the path", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 3, 1.0, "2-3")
+		builder.AddEdge(3, 4, 1.0, "3-4")
+		builder.AddEdge(2, 5, 1.0, "2-5")
+		builder.AddEdge(5, 4, 5.0, "5-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.SecondShortestPath(1, 4)
+		expected := []int{1, 2, 5, 4}
+		if !slicesEqual(path, expected) {
+			t.Errorf("Expected %v, got %v", expected, path)
+		}
+		if cost != 7.0 {
+			t.Errorf("Expected cost 7, got %v", cost)
+		}
+	})
+
+	t.Run("Only non-simple alternatives", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(2, 1, 1.0, "2-1")
+		builder.AddEdge(2, 3, 1.0, "2-3")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		path, cost := dijkstra.SecondShortestPath(1, 3)
+		if path != nil || cost != 0 {
+			t.Errorf("Expected no path, got %v with cost %v", path, cost)
+		}
+	})
+
+	t.Run("Respects the amplifier", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+		builder.AddEdge(1, 3, 2.0, "1-3")
+		builder.AddEdge(2, 4, 2.0, "2-4")
+		builder.AddEdge(3, 4, 3.0, "3-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+		dijkstra.Amplifier = func(origin *Vertex[int, float64], edge *Edge[int, float64]) (float64, bool) {
+			if origin.id == 1 && edge.targetVertex.id == 2 {
+				return 0.0, false // Disable edge 1->2
+			}
+			return edge.cost, true
+		}
+
+		path, _ := dijkstra.SecondShortestPath(1, 4)
+		if path != nil {
+			t.Errorf("Expected no alternative, got %v", path)
+		}
+	})
+
+	t.Run("Non-existent vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "1-2")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		if path, _ := dijkstra.SecondShortestPath(1, 99); path != nil {
+			t.Errorf("Expected nil, got %v", path)
+		}
+		if path, _ := dijkstra.SecondShortestPath(99, 1); path != nil {
+			t.Errorf("Expected nil, got %v", path)
+		}
+	})
+}
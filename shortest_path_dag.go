@@ -0,0 +1,163 @@
+package graph
+
+import "container/heap"
+
+// shortestPathPredecessors runs Dijkstra from the start vertex and records for each
+// vertex all of its predecessors lying on a shortest path, so that ties in cost
+// yield several predecessors. Predecessors are referenced by vertex index, and the
+// lists of the unreachable vertices are nil. The vertex data is left with the
+// reached flags and the distances.
+// Returns ErrNegativeEdgeCost if StrictNonNegative is set and a negative edge cost
+// is encountered.
+func (d *Dijkstra[I, C, V, E]) shortestPathPredecessors(startVertex *Vertex[I, C]) ([][]int, error) {
+	for i := range d.vertexData {
+		d.vertexData[i].visited = false
+		d.vertexData[i].reached = false
+		d.vertexData[i].previous = nil
+		d.vertexData[i].cost = d.maxCost
+	}
+	predecessors := make([][]int, len(d.graph.vertices))
+
+	d.heap.clear()
+	startIdx := startVertex.GetCustomDataIndex()
+	d.vertexData[startIdx].cost = 0
+	d.vertexData[startIdx].reached = true
+	predecessors[startIdx] = []int{}
+	heap.Push(d.heap, startVertex)
+
+	for d.heap.Len() > 0 {
+		current := heap.Pop(d.heap).(*Vertex[I, C])
+		currentIdx := current.GetCustomDataIndex()
+		currentData := &d.vertexData[currentIdx]
+		if currentData.visited {
+			continue
+		}
+		currentData.visited = true
+
+		for _, edge := range current.edges {
+			neighbor := edge.targetVertex
+			neighborIdx := neighbor.GetCustomDataIndex()
+			neighborData := &d.vertexData[neighborIdx]
+			edgeCost, enabled, err := d.edgeCost(current, &edge)
+			if err != nil {
+				return nil, err
+			}
+			if !enabled || neighborIdx == currentIdx || neighborIdx == startIdx {
+				continue // Self-loops and edges back to the start never lie on a shortest path
+			}
+
+			tentativeDistance := saturatingAdd(currentData.cost, edgeCost)
+			if !neighborData.reached || tentativeDistance < neighborData.cost {
+				// Only possible for an unvisited neighbor, since costs are non-negative
+				neighborData.reached = true
+				neighborData.cost = tentativeDistance
+				neighborData.previous = current
+				predecessors[neighborIdx] = append(predecessors[neighborIdx][:0], currentIdx)
+				d.heap.pushOrFix(neighbor)
+			} else if tentativeDistance == neighborData.cost {
+				// A tie, which may reach a visited neighbor through a zero-cost edge
+				predecessors[neighborIdx] = append(predecessors[neighborIdx], currentIdx)
+			}
+		}
+	}
+
+	return predecessors, nil
+}
+
+// ShortestPathDAG computes the shortest path DAG rooted at the start vertex, i.e. for
+// each vertex reachable from the start, the set of its predecessors lying on any of
+// the shortest paths from the start. Unlike the single predecessor recorded by
+// FindShortestPath, all the predecessors are kept when several routes tie in cost,
+// which is what e.g. Brandes' betweenness centrality relies on.
+// Edge costs must be non-negative. Parallel edges yield repeated predecessors.
+// Zero-cost edges are allowed, but if they form a cycle among the vertices at the same
+// distance, the predecessor lists form that cycle too, so the result isn't a DAG then.
+// The Amplifier and the snapshot cost overrides are respected as by FindShortestPath.
+// Returns the predecessors of every reachable vertex (an empty slice for the start
+// vertex), or nil if the start vertex doesn't exist or if StrictNonNegative is set and
+// a negative edge cost is encountered.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) ShortestPathDAG(start I) map[I][]I {
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return nil // Start vertex not found
+	}
+
+	predecessors, err := d.shortestPathPredecessors(startVertex)
+	if err != nil {
+		return nil // Negative edge cost
+	}
+	result := make(map[I][]I)
+	for i := range d.graph.vertices {
+		if predecessors[i] == nil {
+			continue // Unreachable
+		}
+		ids := make([]I, len(predecessors[i]))
+		for j, predecessorIdx := range predecessors[i] {
+			ids[j] = d.graph.vertices[predecessorIdx].id
+		}
+		result[d.graph.vertices[i].id] = ids
+	}
+	return result
+}
+
+// ShortestPathCount returns the number of distinct shortest paths between two vertices,
+// taking all the ties in cost into account (see ShortestPathDAG). Paths using different
+// parallel edges are counted separately. Edge costs must be non-negative.
+// The Amplifier and the snapshot cost overrides are respected as by FindShortestPath.
+// Returns 1 if start and end are the same vertex, 0 if either vertex doesn't exist,
+// the end isn't reachable or StrictNonNegative is set and a negative edge cost is
+// encountered, or -1 if a cycle of zero cost lies on the shortest paths
+// to the end, since they can't be counted in a topological order then.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// WARNING: This function is not thread-safe and should not be called concurrently.
+func (d *Dijkstra[I, C, V, E]) ShortestPathCount(start I, end I) int {
+	startVertex, err := d.graph.GetVertexById(start)
+	if err != nil {
+		return 0 // Start vertex not found
+	}
+	endVertex, err := d.graph.GetVertexById(end)
+	if err != nil {
+		return 0 // End vertex not found
+	}
+
+	predecessors, err := d.shortestPathPredecessors(startVertex)
+	if err != nil {
+		return 0 // Negative edge cost
+	}
+	endIdx := endVertex.GetCustomDataIndex()
+	if predecessors[endIdx] == nil {
+		return 0 // Unreachable
+	}
+
+	// Count the paths in a topological order of the shortest path DAG, since the
+	// predecessors connected by zero-cost edges aren't ordered by the settlement
+	successors := make([][]int, len(predecessors))
+	pending := make([]int, len(predecessors))
+	for i := range predecessors {
+		pending[i] = len(predecessors[i])
+		for _, predecessorIdx := range predecessors[i] {
+			successors[predecessorIdx] = append(successors[predecessorIdx], i)
+		}
+	}
+	counts := make([]int, len(predecessors))
+	startIdx := startVertex.GetCustomDataIndex()
+	counts[startIdx] = 1
+	queue := []int{startIdx}
+	for head := 0; head < len(queue); head++ {
+		for _, successorIdx := range successors[queue[head]] {
+			counts[successorIdx] += counts[queue[head]]
+			pending[successorIdx]--
+			if pending[successorIdx] == 0 {
+				queue = append(queue, successorIdx)
+			}
+		}
+	}
+	if pending[endIdx] > 0 {
+		return -1 // The end is behind a zero-cost cycle, which is never released
+	}
+	return counts[endIdx]
+}
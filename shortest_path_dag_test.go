@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestShortestPathCount(t *testing.T) {
+	t.Run("Grid corners", func(t *testing.T) {
+		graph := GenerateGrid[string, string](3, 4, false)
+		dijkstra := NewDijkstra(graph)
+
+		// Monotone lattice paths: C(2+3, 2) = 10
+		if count := dijkstra.ShortestPathCount(0, 11); count != 10 {
+			t.Errorf("Expected 10 shortest paths, got %d", count)
+		}
+		if count := dijkstra.ShortestPathCount(11, 0); count != 10 {
+			t.Errorf("Expected 10 shortest paths in reverse, got %d", count)
+		}
+		if count := dijkstra.ShortestPathCount(0, 1); count != 1 {
+			t.Errorf("Expected 1 shortest path to a neighbor, got %d", count)
+		}
+	})
+
+	t.Run("Ties through different costs", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 4, 2.0, "edge2-4")
+		builder.AddEdge(1, 3, 2.0, "edge1-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(1, 4, 4.0, "edge1-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		if count := dijkstra.ShortestPathCount(1, 4); count != 2 {
+			t.Errorf("Expected 2 shortest paths, got %d", count)
+		}
+	})
+
+	t.Run("Zero-cost edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(3, 2, 0.0, "edge3-2")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		if count := dijkstra.ShortestPathCount(1, 4); count != 2 {
+			t.Errorf("Expected 2 shortest paths, got %d", count)
+		}
+	})
+
+	t.Run("Trivial and unreachable", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddVertex(3, "isolated")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		if count := dijkstra.ShortestPathCount(1, 1); count != 1 {
+			t.Errorf("Expected 1 path to itself, got %d", count)
+		}
+		if count := dijkstra.ShortestPathCount(1, 3); count != 0 {
+			t.Errorf("Expected 0 paths, got %d", count)
+		}
+		if count := dijkstra.ShortestPathCount(1, 99); count != 0 {
+			t.Errorf("Expected 0 paths, got %d", count)
+		}
+	})
+
+	t.Run("Zero-cost cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(0, 4, 1.0, "edge0-4")
+		builder.AddEdge(4, 2, 0.0, "edge4-2")
+		builder.AddEdge(2, 5, 0.0, "edge2-5")
+		builder.AddEdge(5, 1, 0.0, "edge5-1")
+		builder.AddEdge(1, 5, 0.0, "edge1-5")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		if count := dijkstra.ShortestPathCount(0, 2); count != 1 {
+			t.Errorf("Expected 1 path in front of the cycle, got %d", count)
+		}
+		for _, end := range []int{5, 1} {
+			if count := dijkstra.ShortestPathCount(0, end); count != -1 {
+				t.Errorf("Expected -1 for vertex %d on the zero-cost cycle, got %d", end, count)
+			}
+		}
+	})
+}
+
+func TestShortestPathDAG(t *testing.T) {
+	t.Run("Predecessors of ties", func(t *testing.T) {
+		graph := GenerateGrid[string, string](2, 2, false)
+		dijkstra := NewDijkstra(graph)
+
+		dag := dijkstra.ShortestPathDAG(0)
+		if len(dag) != 4 {
+			t.Fatalf("Expected 4 reachable vertices, got %v", dag)
+		}
+		if len(dag[0]) != 0 {
+			t.Errorf("Expected no predecessors of the start, got %v", dag[0])
+		}
+		if !slicesEqual(dag[1], []int{0}) || !slicesEqual(dag[2], []int{0}) {
+			t.Errorf("Expected predecessor 0 of vertices 1 and 2, got %v and %v", dag[1], dag[2])
+		}
+		if len(dag[3]) != 2 || dag[3][0]+dag[3][1] != 3 {
+			t.Errorf("Expected predecessors 1 and 2 of vertex 3, got %v", dag[3])
+		}
+	})
+
+	t.Run("Unreachable vertices are absent", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		dijkstra := NewDijkstra(graph)
+
+		dag := dijkstra.ShortestPathDAG(1)
+		if _, exists := dag[3]; exists || len(dag) != 2 {
+			t.Errorf("Expected only vertices 1 and 2, got %v", dag)
+		}
+		if dijkstra.ShortestPathDAG(99) != nil {
+			t.Errorf("Expected nil for a non-existent start vertex")
+		}
+	})
+}
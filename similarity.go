@@ -0,0 +1,239 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// SimilarityMetric selects how the similarity of two vertices is computed from
+// their neighborhoods.
+type SimilarityMetric int
+
+const (
+	// JaccardSimilarity is the number of common neighbors divided by the number
+	// of vertices neighboring either of the two vertices.
+	JaccardSimilarity SimilarityMetric = iota
+	// AdamicAdarSimilarity is the sum of 1/log(degree) over the common neighbors,
+	// so that rare (low-degree) common neighbors weigh more.
+	AdamicAdarSimilarity
+)
+
+// VertexSimilarity computes the similarity of two vertices based on their common
+// neighbors, which is the basis for link prediction.
+// The graph is treated as undirected and self-loops are ignored.
+// Returns an error if either vertex doesn't exist or the metric is unknown.
+// The similarity of two vertices without any neighbors is 0.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func VertexSimilarity[I Id, C Cost, V any, E any](g *Graph[I, C, V, E], a I, b I, metric SimilarityMetric) (float64, error) {
+	if metric != JaccardSimilarity && metric != AdamicAdarSimilarity {
+		return 0, errors.New("unknown similarity metric")
+	}
+	aVertex, err := g.GetVertexById(a)
+	if err != nil {
+		return 0, err
+	}
+	bVertex, err := g.GetVertexById(b)
+	if err != nil {
+		return 0, err
+	}
+	adjacency := g.sortedNeighborhoods()
+	return neighborhoodSimilarity(adjacency, aVertex.GetCustomDataIndex(), bVertex.GetCustomDataIndex(), metric), nil
+}
+
+// sortedNeighborhoods returns the undirected adjacency lists (see undirectedAdjacency)
+// without self-loops and with the neighbor indexes sorted, so that neighborhoods
+// can be intersected by merging.
+func (g *Graph[I, C, V, E]) sortedNeighborhoods() [][]int {
+	adjacency := g.undirectedAdjacency()
+	for i := range adjacency {
+		neighbors := adjacency[i][:0]
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx != i {
+				neighbors = append(neighbors, neighborIdx)
+			}
+		}
+		sort.Ints(neighbors)
+		adjacency[i] = neighbors
+	}
+	return adjacency
+}
+
+// neighborhoodSimilarity computes the similarity of the vertices at the given indexes
+// out of the sorted neighborhoods returned by sortedNeighborhoods.
+func neighborhoodSimilarity(adjacency [][]int, aIdx int, bIdx int, metric SimilarityMetric) float64 {
+	aNeighbors, bNeighbors := adjacency[aIdx], adjacency[bIdx]
+	common := 0
+	adamicAdar := 0.0
+	for i, j := 0, 0; i < len(aNeighbors) && j < len(bNeighbors); {
+		switch {
+		case aNeighbors[i] < bNeighbors[j]:
+			i++
+		case aNeighbors[i] > bNeighbors[j]:
+			j++
+		default:
+			common++
+			// A common neighbor of two distinct vertices has a degree of at least 2
+			if degree := len(adjacency[aNeighbors[i]]); degree > 1 {
+				adamicAdar += 1 / math.Log(float64(degree))
+			}
+			i++
+			j++
+		}
+	}
+
+	if metric == AdamicAdarSimilarity {
+		return adamicAdar
+	}
+	union := len(aNeighbors) + len(bNeighbors) - common
+	if union == 0 {
+		return 0
+	}
+	return float64(common) / float64(union)
+}
+
+// LinkPrediction is a pair of non-adjacent vertices with its similarity score,
+// as returned by PredictLinks.
+type LinkPrediction[I Id] struct {
+	A     I       // First vertex of the pair
+	B     I       // Second vertex of the pair
+	Score float64 // Similarity of the vertices
+}
+
+// PredictLinks ranks the pairs of non-adjacent vertices by their similarity (see
+// VertexSimilarity) and returns the topK best ones, i.e. the most likely future edges.
+// The graph is treated as undirected. Only pairs with at least one common neighbor
+// (and hence a positive score) are considered. Pairs with equal scores are ordered
+// by the vertex index order. Returns nil if topK isn't positive or the metric is unknown.
+// Time complexity: O(V * D^3 + P log P) where V is the number of vertices, D is the
+// maximum degree and P is the number of candidate pairs.
+// Space complexity: O(V + E + P) where E is the number of edges.
+func PredictLinks[I Id, C Cost, V any, E any](g *Graph[I, C, V, E], metric SimilarityMetric, topK int) []LinkPrediction[I] {
+	if topK <= 0 || (metric != JaccardSimilarity && metric != AdamicAdarSimilarity) {
+		return nil
+	}
+
+	// The neighborhoods are computed once and shared by all the pairs
+	adjacency := g.sortedNeighborhoods()
+	type candidate struct {
+		aIdx, bIdx int
+		score      float64
+	}
+	var candidates []candidate
+	seen := make([]int, len(g.vertices)) // Stamp of the last vertex pairing with each vertex
+	for i := range seen {
+		seen[i] = -1
+	}
+
+	for aIdx := range adjacency {
+		// Exclude the vertex itself and its neighbors
+		seen[aIdx] = aIdx
+		for _, neighborIdx := range adjacency[aIdx] {
+			seen[neighborIdx] = aIdx
+		}
+		// Candidates are the vertices two hops away
+		for _, neighborIdx := range adjacency[aIdx] {
+			for _, bIdx := range adjacency[neighborIdx] {
+				if bIdx < aIdx || seen[bIdx] == aIdx {
+					continue
+				}
+				seen[bIdx] = aIdx
+				candidates = append(candidates, candidate{
+					aIdx:  aIdx,
+					bIdx:  bIdx,
+					score: neighborhoodSimilarity(adjacency, aIdx, bIdx, metric),
+				})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		if candidates[i].aIdx != candidates[j].aIdx {
+			return candidates[i].aIdx < candidates[j].aIdx
+		}
+		return candidates[i].bIdx < candidates[j].bIdx
+	})
+	if len(candidates) > topK {
+		candidates = candidates[:topK]
+	}
+
+	predictions := make([]LinkPrediction[I], len(candidates))
+	for i, c := range candidates {
+		predictions[i] = LinkPrediction[I]{A: g.vertices[c.aIdx].id, B: g.vertices[c.bIdx].id, Score: c.score}
+	}
+	return predictions
+}
+
+// NodeSimilarity computes the Jaccard index of the neighborhoods of two vertices,
+// i.e. the number of their common neighbors divided by the number of vertices
+// neighboring either of them. It's a shortcut for VertexSimilarity with
+// JaccardSimilarity, so the graph is treated as undirected and self-loops are ignored.
+// Returns 0 if either vertex doesn't exist or both neighborhoods are empty.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) NodeSimilarity(a I, b I) float64 {
+	similarity, err := VertexSimilarity(g, a, b, JaccardSimilarity)
+	if err != nil {
+		return 0
+	}
+	return similarity
+}
+
+// SimilarNodes returns up to topN vertices most similar to the given one by the Jaccard
+// index of their neighborhoods (see NodeSimilarity), e.g. for "people you may know"
+// suggestions. Only vertices sharing at least one neighbor with the given one (and
+// hence with a positive similarity) are considered, whether they are adjacent to it
+// or not. Vertices with equal similarity are ordered by the vertex index order.
+// Returns nil if the vertex doesn't exist or topN isn't positive.
+// Time complexity: O(V log V + E + D^3) where V is the number of vertices, E is the
+// number of edges and D is the maximum degree.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) SimilarNodes(a I, topN int) []I {
+	vertex, err := g.GetVertexById(a)
+	if err != nil || topN <= 0 {
+		return nil
+	}
+
+	adjacency := g.sortedNeighborhoods()
+	aIdx := vertex.GetCustomDataIndex()
+	type candidate struct {
+		idx   int
+		score float64
+	}
+	var candidates []candidate
+	seen := make([]bool, len(g.vertices))
+	seen[aIdx] = true
+	// Candidates are the vertices two hops away
+	for _, neighborIdx := range adjacency[aIdx] {
+		for _, bIdx := range adjacency[neighborIdx] {
+			if seen[bIdx] {
+				continue
+			}
+			seen[bIdx] = true
+			candidates = append(candidates, candidate{
+				idx:   bIdx,
+				score: neighborhoodSimilarity(adjacency, aIdx, bIdx, JaccardSimilarity),
+			})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].idx < candidates[j].idx
+	})
+	if len(candidates) > topN {
+		candidates = candidates[:topN]
+	}
+
+	result := make([]I, len(candidates))
+	for i, c := range candidates {
+		result[i] = g.vertices[c.idx].id
+	}
+	return result
+}
@@ -0,0 +1,205 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVertexSimilarity(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	// Vertices 1 and 2 share all their neighbors (3 and 4)
+	builder.AddEdge(1, 3, 1.0, "edge1-3")
+	builder.AddEdge(1, 4, 1.0, "edge1-4")
+	builder.AddEdge(3, 2, 1.0, "edge3-2")
+	builder.AddEdge(4, 2, 1.0, "edge4-2")
+	// Vertex 5 only neighbors vertex 6
+	builder.AddEdge(5, 6, 1.0, "edge5-6")
+	// Vertex 4 also neighbors 7
+	builder.AddEdge(4, 7, 1.0, "edge4-7")
+	graph := builder.BuildDirected()
+
+	t.Run("Identical neighborhoods have Jaccard 1", func(t *testing.T) {
+		similarity, err := VertexSimilarity(graph, 1, 2, JaccardSimilarity)
+
+		if err != nil || similarity != 1.0 {
+			t.Errorf("Expected Jaccard 1.0 and no error, got %f, %v", similarity, err)
+		}
+	})
+
+	t.Run("Disjoint neighborhoods have Jaccard 0", func(t *testing.T) {
+		similarity, err := VertexSimilarity(graph, 1, 5, JaccardSimilarity)
+
+		if err != nil || similarity != 0.0 {
+			t.Errorf("Expected Jaccard 0.0 and no error, got %f, %v", similarity, err)
+		}
+	})
+
+	t.Run("Partial overlap", func(t *testing.T) {
+		similarity, _ := VertexSimilarity(graph, 3, 4, JaccardSimilarity)
+
+		// N(3) = {1, 2}, N(4) = {1, 2, 7}
+		if math.Abs(similarity-2.0/3.0) > 1e-9 {
+			t.Errorf("Expected Jaccard 2/3, got %f", similarity)
+		}
+	})
+
+	t.Run("Adamic-Adar", func(t *testing.T) {
+		similarity, err := VertexSimilarity(graph, 1, 2, AdamicAdarSimilarity)
+
+		// Common neighbors 3 (degree 2) and 4 (degree 3)
+		expected := 1/math.Log(2) + 1/math.Log(3)
+		if err != nil || math.Abs(similarity-expected) > 1e-9 {
+			t.Errorf("Expected Adamic-Adar %f and no error, got %f, %v", expected, similarity, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, err := VertexSimilarity(graph, 999, 1, JaccardSimilarity); err == nil {
+			t.Error("Expected error for non-existent vertex")
+		}
+
+		if _, err := VertexSimilarity(graph, 1, 2, SimilarityMetric(42)); err == nil {
+			t.Error("Expected error for unknown metric")
+		}
+	})
+}
+
+func TestPredictLinks(t *testing.T) {
+	builder := &Builder[string, float64, string, string]{}
+	// Alice and Bob share three friends but aren't connected
+	for _, friend := range []string{"Carol", "Dave", "Erin"} {
+		builder.AddBiEdge("Alice", friend, 1.0, "")
+		builder.AddBiEdge("Bob", friend, 1.0, "")
+	}
+	// Carol and Frank share a single friend
+	builder.AddBiEdge("Dave", "Frank", 1.0, "")
+	graph := builder.BuildDirected()
+
+	t.Run("Pair sharing many neighbors ranks first", func(t *testing.T) {
+		for _, metric := range []SimilarityMetric{JaccardSimilarity, AdamicAdarSimilarity} {
+			predictions := PredictLinks(graph, metric, 3)
+
+			if len(predictions) != 3 {
+				t.Fatalf("Expected 3 predictions, got %d", len(predictions))
+			}
+
+			top := predictions[0]
+			if !(top.A == "Alice" && top.B == "Bob") && !(top.A == "Bob" && top.B == "Alice") {
+				t.Errorf("Expected Alice-Bob to rank first (metric %d), got %s-%s", metric, top.A, top.B)
+			}
+
+			for i := 1; i < len(predictions); i++ {
+				if predictions[i].Score > predictions[i-1].Score {
+					t.Errorf("Expected predictions sorted by score, got %v", predictions)
+				}
+			}
+		}
+	})
+
+	t.Run("Adjacent pairs are excluded", func(t *testing.T) {
+		predictions := PredictLinks(graph, JaccardSimilarity, 100)
+
+		for _, prediction := range predictions {
+			if graph.SomeEdges(func(vertex *Vertex[string, float64], edge *Edge[string, float64]) bool {
+				return vertex.GetId() == prediction.A && edge.GetTargetVertex().GetId() == prediction.B
+			}) {
+				t.Errorf("Expected adjacent pair %s-%s to be excluded", prediction.A, prediction.B)
+			}
+			if prediction.A == prediction.B {
+				t.Errorf("Expected no self pairs, got %s", prediction.A)
+			}
+		}
+	})
+
+	t.Run("Non-positive topK", func(t *testing.T) {
+		if predictions := PredictLinks(graph, JaccardSimilarity, 0); predictions != nil {
+			t.Errorf("Expected nil, got %v", predictions)
+		}
+	})
+}
+
+func TestNodeSimilarity(t *testing.T) {
+	// 1 and 2 follow the same people, 3 follows someone else
+	buildSocialGraph := func() *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 10, 1.0, "follows")
+		builder.AddEdge(1, 11, 1.0, "follows")
+		builder.AddEdge(2, 10, 1.0, "follows")
+		builder.AddEdge(2, 11, 1.0, "follows")
+		builder.AddEdge(3, 12, 1.0, "follows")
+		builder.AddEdge(4, 10, 1.0, "follows")
+		builder.AddVertex(5, "loner")
+		return builder.BuildDirected()
+	}
+
+	t.Run("Identical neighborhoods", func(t *testing.T) {
+		graph := buildSocialGraph()
+		if similarity := graph.NodeSimilarity(1, 2); similarity != 1.0 {
+			t.Errorf("Expected similarity 1, got %v", similarity)
+		}
+	})
+
+	t.Run("Disjoint neighborhoods", func(t *testing.T) {
+		graph := buildSocialGraph()
+		if similarity := graph.NodeSimilarity(1, 3); similarity != 0 {
+			t.Errorf("Expected similarity 0, got %v", similarity)
+		}
+	})
+
+	t.Run("Partial overlap", func(t *testing.T) {
+		graph := buildSocialGraph()
+		if similarity := graph.NodeSimilarity(1, 4); similarity != 0.5 {
+			t.Errorf("Expected similarity 0.5, got %v", similarity)
+		}
+	})
+
+	t.Run("Empty neighborhoods and unknown vertices", func(t *testing.T) {
+		graph := buildSocialGraph()
+		if similarity := graph.NodeSimilarity(5, 5); similarity != 0 {
+			t.Errorf("Expected similarity 0, got %v", similarity)
+		}
+		if similarity := graph.NodeSimilarity(1, 99); similarity != 0 {
+			t.Errorf("Expected similarity 0, got %v", similarity)
+		}
+	})
+}
+
+func TestSimilarNodes(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 10, 1.0, "follows")
+	builder.AddEdge(1, 11, 1.0, "follows")
+	builder.AddEdge(2, 10, 1.0, "follows")
+	builder.AddEdge(2, 11, 1.0, "follows")
+	builder.AddEdge(3, 12, 1.0, "follows")
+	builder.AddEdge(4, 10, 1.0, "follows")
+	graph := builder.BuildDirected()
+
+	t.Run("Ranked by similarity", func(t *testing.T) {
+		expected := []int{2, 4}
+		if similar := graph.SimilarNodes(1, 5); !slicesEqual(similar, expected) {
+			t.Errorf("Expected %v, got %v", expected, similar)
+		}
+	})
+
+	t.Run("Limited to topN", func(t *testing.T) {
+		expected := []int{2}
+		if similar := graph.SimilarNodes(1, 1); !slicesEqual(similar, expected) {
+			t.Errorf("Expected %v, got %v", expected, similar)
+		}
+	})
+
+	t.Run("No similar vertices", func(t *testing.T) {
+		if similar := graph.SimilarNodes(3, 5); len(similar) != 0 {
+			t.Errorf("Expected no similar vertices, got %v", similar)
+		}
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		if similar := graph.SimilarNodes(99, 5); similar != nil {
+			t.Errorf("Expected nil, got %v", similar)
+		}
+		if similar := graph.SimilarNodes(1, 0); similar != nil {
+			t.Errorf("Expected nil, got %v", similar)
+		}
+	})
+}
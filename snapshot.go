@@ -0,0 +1,63 @@
+package graph
+
+import "errors"
+
+// GraphSnapshot is a lightweight "what-if" view of a graph.
+// It shares the immutable topology and data of the underlying graph and keeps
+// only a small overlay of edge cost overrides, so alternative scenarios can be
+// evaluated without copying the whole graph.
+// An override applies to every edge going from the origin to the target vertex.
+// The snapshot is not thread-safe while overrides are being changed.
+type GraphSnapshot[I Id, C Cost, V any, E any] struct {
+	graph     *Graph[I, C, V, E]
+	overrides map[EdgeKey[I]]C
+}
+
+// Snapshot creates a snapshot of the graph without any cost overrides.
+// Time complexity: O(1).
+func (g *Graph[I, C, V, E]) Snapshot() *GraphSnapshot[I, C, V, E] {
+	return &GraphSnapshot[I, C, V, E]{
+		graph:     g,
+		overrides: make(map[EdgeKey[I]]C),
+	}
+}
+
+// GetGraph returns the underlying graph of the snapshot.
+func (s *GraphSnapshot[I, C, V, E]) GetGraph() *Graph[I, C, V, E] {
+	return s.graph
+}
+
+// OverrideEdgeCost replaces the cost of the edges going from the origin to the target
+// vertex within the snapshot. The underlying graph isn't modified.
+// Returns an error if there is no such edge in the graph.
+// Time complexity: O(D) where D is the out-degree of the origin vertex.
+func (s *GraphSnapshot[I, C, V, E]) OverrideEdgeCost(origin I, target I, cost C) error {
+	originVertex, err := s.graph.GetVertexById(origin)
+	if err != nil {
+		return err
+	}
+	for _, edge := range originVertex.edges {
+		if edge.targetVertex.id == target {
+			s.overrides[EdgeKey[I]{Origin: origin, Target: target}] = cost
+			return nil
+		}
+	}
+	return errors.New("edge not found")
+}
+
+// ResetEdgeCost removes the cost override of the edges going from the origin to the target vertex.
+func (s *GraphSnapshot[I, C, V, E]) ResetEdgeCost(origin I, target I) {
+	delete(s.overrides, EdgeKey[I]{Origin: origin, Target: target})
+}
+
+// GetEdgeCost returns the cost of the edge as seen by the snapshot,
+// which is the override if there is one, or the original edge cost otherwise.
+// Time complexity: O(1).
+func (s *GraphSnapshot[I, C, V, E]) GetEdgeCost(origin *Vertex[I, C], edge *Edge[I, C]) C {
+	if len(s.overrides) > 0 {
+		if cost, ok := s.overrides[EdgeKey[I]{Origin: origin.id, Target: edge.targetVertex.id}]; ok {
+			return cost
+		}
+	}
+	return edge.cost
+}
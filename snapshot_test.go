@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphSnapshot(t *testing.T) {
+	buildGraph := func() *Graph[int, float64, string, string] {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(1, 3, 2.0, "edge1-3")
+		builder.AddEdge(3, 4, 2.0, "edge3-4")
+		return builder.BuildDirected()
+	}
+
+	t.Run("Override changes Dijkstra result", func(t *testing.T) {
+		graph := buildGraph()
+		snapshot := graph.Snapshot()
+		if err := snapshot.OverrideEdgeCost(2, 4, 10.0); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		path := NewDijkstraForSnapshot(snapshot).FindShortestPath(1, 4)
+		if !slicesEqual(path, []int{1, 3, 4}) {
+			t.Errorf("Expected snapshot path [1 3 4], got %v", path)
+		}
+
+		// The base graph must stay intact
+		path = NewDijkstra(graph).FindShortestPath(1, 4)
+		if !slicesEqual(path, []int{1, 2, 4}) {
+			t.Errorf("Expected base path [1 2 4], got %v", path)
+		}
+
+		vertex, _ := graph.GetVertexById(2)
+		if cost := vertex.GetEdges()[0].GetCost(); cost != 1.0 {
+			t.Errorf("Expected base edge cost 1.0, got %f", cost)
+		}
+	})
+
+	t.Run("Amplifier receives overridden costs", func(t *testing.T) {
+		graph := buildGraph()
+		snapshot := graph.Snapshot()
+		if err := snapshot.OverrideEdgeCost(2, 4, 10.0); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		dijkstra := NewDijkstraForSnapshot(snapshot)
+		var amplified []float64
+		dijkstra.Amplifier = func(origin *Vertex[int, float64], edge *Edge[int, float64]) (float64, bool) {
+			if origin.GetId() == 2 {
+				amplified = append(amplified, edge.GetCost())
+			}
+			return edge.GetCost() * 2, true
+		}
+
+		// 1-2-4 costs (1 + 10) * 2 with the override, more than (2 + 2) * 2 via 3
+		path := dijkstra.FindShortestPath(1, 4)
+		if !slicesEqual(path, []int{1, 3, 4}) {
+			t.Errorf("Expected path [1 3 4], got %v", path)
+		}
+		if len(amplified) != 1 || amplified[0] != 10.0 {
+			t.Errorf("Expected the Amplifier to receive the overridden cost 10, got %v", amplified)
+		}
+	})
+
+	t.Run("Reset override", func(t *testing.T) {
+		graph := buildGraph()
+		snapshot := graph.Snapshot()
+		snapshot.OverrideEdgeCost(2, 4, 10.0)
+		snapshot.ResetEdgeCost(2, 4)
+
+		path := NewDijkstraForSnapshot(snapshot).FindShortestPath(1, 4)
+		if !slicesEqual(path, []int{1, 2, 4}) {
+			t.Errorf("Expected path [1 2 4], got %v", path)
+		}
+	})
+
+	t.Run("Independent snapshots", func(t *testing.T) {
+		graph := buildGraph()
+		first := graph.Snapshot()
+		second := graph.Snapshot()
+		first.OverrideEdgeCost(2, 4, 10.0)
+
+		vertex, _ := graph.GetVertexById(2)
+		edge := &vertex.GetEdges()[0]
+		if cost := first.GetEdgeCost(vertex, edge); cost != 10.0 {
+			t.Errorf("Expected overridden cost 10.0, got %f", cost)
+		}
+		if cost := second.GetEdgeCost(vertex, edge); cost != 1.0 {
+			t.Errorf("Expected original cost 1.0, got %f", cost)
+		}
+		if second.GetGraph() != graph {
+			t.Error("Expected snapshot to share the underlying graph")
+		}
+	})
+
+	t.Run("Override non-existent edge", func(t *testing.T) {
+		graph := buildGraph()
+		snapshot := graph.Snapshot()
+
+		if err := snapshot.OverrideEdgeCost(1, 4, 1.0); err == nil {
+			t.Error("Expected error for non-existent edge")
+		}
+		if err := snapshot.OverrideEdgeCost(999, 4, 1.0); err == nil {
+			t.Error("Expected error for non-existent vertex")
+		}
+	})
+}
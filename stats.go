@@ -0,0 +1,78 @@
+package graph
+
+// GraphStats is a quick profile of the structure of a graph, e.g. an imported network.
+type GraphStats struct {
+	VertexCount int
+	EdgeCount   int
+	// The out-degrees of the vertices (the self-loops and parallel edges are counted)
+	MinOutDegree int
+	MaxOutDegree int
+	AvgOutDegree float64
+	// The in-degrees of the vertices (the self-loops and parallel edges are counted)
+	MinInDegree int
+	MaxInDegree int
+	AvgInDegree float64
+	// The ratio of the number of edges to the number of possible edges between distinct
+	// vertices, V * (V - 1), or zero if there are less than two vertices
+	Density float64
+	// The number of vertices with outgoing edges but without incoming ones
+	SourceCount int
+	// The number of vertices with incoming edges but without outgoing ones
+	SinkCount int
+	// The number of vertices without any edges
+	IsolatedCount int
+}
+
+// Stats computes the degree sequence statistics and the density of the graph.
+// All the degree statistics are zero for an empty graph.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) Stats() GraphStats {
+	vertexCount := len(g.vertices)
+	stats := GraphStats{VertexCount: vertexCount, EdgeCount: g.edgeCount}
+	if vertexCount == 0 {
+		return stats
+	}
+
+	inDegree := make([]int, vertexCount)
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			inDegree[edge.targetVertex.GetCustomDataIndex()]++
+		}
+	}
+
+	stats.MinOutDegree = len(g.vertices[0].edges)
+	stats.MinInDegree = inDegree[0]
+	for i := range g.vertices {
+		outDegree := len(g.vertices[i].edges)
+		if outDegree < stats.MinOutDegree {
+			stats.MinOutDegree = outDegree
+		}
+		if outDegree > stats.MaxOutDegree {
+			stats.MaxOutDegree = outDegree
+		}
+		if inDegree[i] < stats.MinInDegree {
+			stats.MinInDegree = inDegree[i]
+		}
+		if inDegree[i] > stats.MaxInDegree {
+			stats.MaxInDegree = inDegree[i]
+		}
+		switch {
+		case outDegree == 0 && inDegree[i] == 0:
+			stats.IsolatedCount++
+		case inDegree[i] == 0:
+			stats.SourceCount++
+		case outDegree == 0:
+			stats.SinkCount++
+		}
+	}
+
+	// Every edge adds one to both an out-degree and an in-degree
+	stats.AvgOutDegree = float64(g.edgeCount) / float64(vertexCount)
+	stats.AvgInDegree = stats.AvgOutDegree
+	if vertexCount > 1 {
+		stats.Density = float64(g.edgeCount) / float64(vertexCount*(vertexCount-1))
+	}
+
+	return stats
+}
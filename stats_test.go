@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphStats(t *testing.T) {
+	t.Run("Sources, sinks and isolated vertices", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(1, 3, 1, "edge1-3")
+		builder.AddEdge(2, 3, 1, "edge2-3")
+		builder.AddEdge(4, 3, 1, "edge4-3")
+		builder.AddVertex(5, "vertex5")
+
+		graph := builder.BuildDirected()
+		stats := graph.Stats()
+
+		expected := GraphStats{
+			VertexCount:   5,
+			EdgeCount:     4,
+			MinOutDegree:  0,
+			MaxOutDegree:  2,
+			AvgOutDegree:  0.8,
+			MinInDegree:   0,
+			MaxInDegree:   3,
+			AvgInDegree:   0.8,
+			Density:       0.2,
+			SourceCount:   2,
+			SinkCount:     1,
+			IsolatedCount: 1,
+		}
+		if stats != expected {
+			t.Errorf("Expected %+v, got %+v", expected, stats)
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+
+		graph := builder.BuildDirected()
+		stats := graph.Stats()
+
+		if stats != (GraphStats{}) {
+			t.Errorf("Expected zero stats, got %+v", stats)
+		}
+	})
+
+	t.Run("Single vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+
+		graph := builder.BuildDirected()
+		stats := graph.Stats()
+
+		if stats.Density != 0 || stats.IsolatedCount != 1 {
+			t.Errorf("Expected density 0 and 1 isolated vertex, got %+v", stats)
+		}
+	})
+}
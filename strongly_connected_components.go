@@ -0,0 +1,219 @@
+package graph
+
+// The data that is attached to the vertices by the StronglyConnectedComponents algorithm.
+type stronglyConnectedComponentsVertexData struct {
+	index   int  // DFS discovery index, -1 if the vertex hasn't been visited yet
+	lowLink int  // Smallest discovery index reachable from the vertex's DFS subtree
+	onStack bool // Whether the vertex is on the Tarjan's stack
+}
+
+// The StronglyConnectedComponents algorithm Use-Case (aka Command) object.
+// It contains the precomputed strongly connected components data and provides
+// methods to query the results without recomputing.
+type StronglyConnectedComponents[I Id, C Cost, V any, E any] struct {
+	graph      *Graph[I, C, V, E]
+	components [][]I
+	// Component ID of each vertex, indexed by the vertex's GetCustomDataIndex()
+	componentIds []int
+}
+
+// FindStronglyConnectedComponents finds all strongly connected components in the graph
+// using an iterative version of Tarjan's algorithm.
+// Two vertices belong to the same strongly connected component if each of them is
+// reachable from the other one. The component IDs are the indexes into GetComponents()
+// and are assigned in reverse topological order of the condensed graph.
+// Returns a StronglyConnectedComponents instance with precomputed results.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func FindStronglyConnectedComponents[I Id, C Cost, V any, E any](graph *Graph[I, C, V, E]) *StronglyConnectedComponents[I, C, V, E] {
+	vertexData := make([]stronglyConnectedComponentsVertexData, len(graph.vertices))
+	scc := &StronglyConnectedComponents[I, C, V, E]{
+		graph:        graph,
+		componentIds: make([]int, len(graph.vertices)),
+	}
+
+	// Initialize vertex data for all vertices
+	for i := range vertexData {
+		vertexData[i].index = -1
+		scc.componentIds[i] = -1
+	}
+
+	type stackItem struct {
+		vertexIdx int
+		edgeIdx   int // Index of the next outgoing edge to process
+	}
+	var callStack []stackItem
+	var tarjanStack []int
+	index := 0
+
+	for root := range graph.vertices {
+		if vertexData[root].index >= 0 {
+			continue
+		}
+
+		vertexData[root] = stronglyConnectedComponentsVertexData{index: index, lowLink: index, onStack: true}
+		index++
+		tarjanStack = append(tarjanStack, root)
+		callStack = append(callStack[:0], stackItem{vertexIdx: root})
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			currentIdx := top.vertexIdx
+			currentData := &vertexData[currentIdx]
+			edges := graph.vertices[currentIdx].edges
+
+			// Descend into the next unvisited neighbor
+			if top.edgeIdx < len(edges) {
+				neighborIdx := edges[top.edgeIdx].targetVertex.GetCustomDataIndex()
+				top.edgeIdx++
+				neighborData := &vertexData[neighborIdx]
+				if neighborData.index < 0 {
+					*neighborData = stronglyConnectedComponentsVertexData{index: index, lowLink: index, onStack: true}
+					index++
+					tarjanStack = append(tarjanStack, neighborIdx)
+					callStack = append(callStack, stackItem{vertexIdx: neighborIdx})
+				} else if neighborData.onStack && neighborData.index < currentData.lowLink {
+					currentData.lowLink = neighborData.index
+				}
+				continue
+			}
+
+			// All neighbors are processed, the vertex may be the root of a component
+			callStack = callStack[:len(callStack)-1]
+			if currentData.lowLink == currentData.index {
+				componentId := len(scc.components)
+				var component []I
+				for {
+					memberIdx := tarjanStack[len(tarjanStack)-1]
+					tarjanStack = tarjanStack[:len(tarjanStack)-1]
+					vertexData[memberIdx].onStack = false
+					scc.componentIds[memberIdx] = componentId
+					component = append(component, graph.vertices[memberIdx].id)
+					if memberIdx == currentIdx {
+						break
+					}
+				}
+				scc.components = append(scc.components, component)
+			}
+
+			// Propagate the low-link value to the parent
+			if len(callStack) > 0 {
+				parentData := &vertexData[callStack[len(callStack)-1].vertexIdx]
+				if currentData.lowLink < parentData.lowLink {
+					parentData.lowLink = currentData.lowLink
+				}
+			}
+		}
+	}
+
+	return scc
+}
+
+// GetComponents returns the precomputed strongly connected components.
+// Returns a slice of slices, where each inner slice contains the vertex IDs
+// that belong to the same strongly connected component.
+// Time complexity: O(1) - returns precomputed data.
+func (scc *StronglyConnectedComponents[I, C, V, E]) GetComponents() [][]I {
+	return scc.components
+}
+
+// GetComponentCount returns the number of strongly connected components in the graph.
+// Time complexity: O(1) - returns precomputed data.
+func (scc *StronglyConnectedComponents[I, C, V, E]) GetComponentCount() int {
+	return len(scc.components)
+}
+
+// GetComponentId returns the ID of the strongly connected component containing the given vertex.
+// The ID is the index of the component in the GetComponents() slice.
+// Returns an error if the vertex is not found in the graph.
+// Time complexity: O(1).
+func (scc *StronglyConnectedComponents[I, C, V, E]) GetComponentId(vertexId I) (int, error) {
+	vertex, err := scc.graph.GetVertexById(vertexId)
+	if err != nil {
+		return -1, err
+	}
+	return scc.componentIds[vertex.GetCustomDataIndex()], nil
+}
+
+// ComponentEdges returns the edges lying inside the strongly connected components.
+// Maps each component ID to the edges whose both endpoints belong to that component.
+// Edges connecting different components are excluded, as are components without
+// internal edges (singleton components without self-loops).
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (scc *StronglyConnectedComponents[I, C, V, E]) ComponentEdges() map[int][]EdgeDto[I, C, E] {
+	result := make(map[int][]EdgeDto[I, C, E])
+	g := scc.graph
+	for i := range g.vertices {
+		componentId := scc.componentIds[i]
+		for j := range g.vertices[i].edges {
+			edge := &g.vertices[i].edges[j]
+			if scc.componentIds[edge.targetVertex.GetCustomDataIndex()] != componentId {
+				continue
+			}
+			result[componentId] = append(result[componentId], &BasicEdgeDto[I, C, E]{
+				Origin: g.vertices[i].id,
+				Target: edge.targetVertex.id,
+				Cost:   edge.cost,
+				Data:   g.customEdgeData[edge.customDataIndex],
+			})
+		}
+	}
+	return result
+}
+
+// Condensation builds the condensed graph, where every strongly connected component
+// is a single super-vertex and an edge connects two components if any edge connects
+// their members. The condensed graph is always a DAG, which makes it the basis for
+// e.g. 2-SAT and other meta-level analyses.
+// The super-vertex IDs are the component IDs and their data are the member IDs
+// (see GetComponents). The edges between the same pair of components are collapsed
+// into one with the cost and data of the cheapest of them. Edges inside a component
+// are dropped, so the condensed graph has no self-loops.
+// Returns the condensed graph and the mapping from the original vertex IDs to the
+// component IDs.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (scc *StronglyConnectedComponents[I, C, V, E]) Condensation() (*Graph[int, C, []I, E], map[I]int) {
+	g := scc.graph
+	mapping := make(map[I]int, len(g.vertices))
+	for i := range g.vertices {
+		mapping[g.vertices[i].id] = scc.componentIds[i]
+	}
+
+	// The cheapest edge between each pair of components, in the order of discovery
+	var edges []*BasicEdgeDto[int, C, E]
+	positions := make(map[EdgeKey[int]]int)
+	for i := range g.vertices {
+		originId := scc.componentIds[i]
+		for _, edge := range g.vertices[i].edges {
+			targetId := scc.componentIds[edge.targetVertex.GetCustomDataIndex()]
+			if targetId == originId {
+				continue
+			}
+			key := EdgeKey[int]{Origin: originId, Target: targetId}
+			position, exists := positions[key]
+			if !exists {
+				positions[key] = len(edges)
+				edges = append(edges, &BasicEdgeDto[int, C, E]{
+					Origin: originId,
+					Target: targetId,
+					Cost:   edge.cost,
+					Data:   g.customEdgeData[edge.customDataIndex],
+				})
+			} else if edge.cost < edges[position].Cost {
+				edges[position].Cost = edge.cost
+				edges[position].Data = g.customEdgeData[edge.customDataIndex]
+			}
+		}
+	}
+
+	builder := &Builder[int, C, []I, E]{}
+	builder.Reserve(len(scc.components), len(edges))
+	for componentId, members := range scc.components {
+		builder.AddVertex(componentId, members)
+	}
+	for _, edge := range edges {
+		builder.AddEdgeDto(edge)
+	}
+	return builder.BuildDirected(), mapping
+}
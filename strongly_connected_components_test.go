@@ -0,0 +1,212 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFindStronglyConnectedComponents(t *testing.T) {
+	t.Run("Cycle and tail", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+
+		graph := builder.BuildDirected()
+		scc := FindStronglyConnectedComponents(graph)
+
+		if scc.GetComponentCount() != 3 {
+			t.Fatalf("Expected 3 components, got %d: %v", scc.GetComponentCount(), scc.GetComponents())
+		}
+
+		id1, _ := scc.GetComponentId(1)
+		id2, _ := scc.GetComponentId(2)
+		id3, _ := scc.GetComponentId(3)
+		id4, _ := scc.GetComponentId(4)
+		id5, _ := scc.GetComponentId(5)
+
+		if id1 != id2 || id2 != id3 {
+			t.Errorf("Expected vertices 1, 2, 3 in the same component, got %d, %d, %d", id1, id2, id3)
+		}
+
+		if id4 == id3 || id5 == id4 {
+			t.Errorf("Expected vertices 4 and 5 in separate components, got %d, %d", id4, id5)
+		}
+
+		if len(scc.GetComponents()[id1]) != 3 {
+			t.Errorf("Expected the cycle component to have 3 vertices, got %v", scc.GetComponents()[id1])
+		}
+	})
+
+	t.Run("Two cycles connected one way", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 3, 1.0, "edge4-3")
+
+		graph := builder.BuildDirected()
+		scc := FindStronglyConnectedComponents(graph)
+
+		if scc.GetComponentCount() != 2 {
+			t.Errorf("Expected 2 components, got %d: %v", scc.GetComponentCount(), scc.GetComponents())
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+		scc := FindStronglyConnectedComponents(graph)
+
+		if scc.GetComponentCount() != 0 {
+			t.Errorf("Expected 0 components, got %d", scc.GetComponentCount())
+		}
+	})
+
+	t.Run("Non-existent vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		graph := builder.BuildDirected()
+		scc := FindStronglyConnectedComponents(graph)
+
+		if _, err := scc.GetComponentId(999); err == nil {
+			t.Error("Expected error for non-existent vertex")
+		}
+	})
+}
+
+func TestStronglyConnectedComponentsComponentEdges(t *testing.T) {
+	builder := &Builder[int, float64, string, string]{}
+	builder.AddEdge(1, 2, 1.0, "edge1-2")
+	builder.AddEdge(2, 3, 2.0, "edge2-3")
+	builder.AddEdge(3, 1, 3.0, "edge3-1")
+	builder.AddEdge(3, 4, 4.0, "edge3-4")
+	builder.AddEdge(4, 5, 5.0, "edge4-5")
+	builder.AddEdge(5, 5, 6.0, "edge5-5")
+
+	graph := builder.BuildDirected()
+	scc := FindStronglyConnectedComponents(graph)
+	componentEdges := scc.ComponentEdges()
+
+	t.Run("Cycle reports its internal edges", func(t *testing.T) {
+		id, _ := scc.GetComponentId(1)
+		edges := componentEdges[id]
+
+		if len(edges) != 3 {
+			t.Fatalf("Expected 3 internal edges, got %d", len(edges))
+		}
+
+		for _, edge := range edges {
+			if edge.GetOrigin() == 3 && edge.GetTarget() == 4 {
+				t.Error("Inter-component edge 3 -> 4 must be excluded")
+			}
+			if edge.GetData() != fmt.Sprintf("edge%d-%d", edge.GetOrigin(), edge.GetTarget()) {
+				t.Errorf("Unexpected data %q for edge %d -> %d", edge.GetData(), edge.GetOrigin(), edge.GetTarget())
+			}
+		}
+	})
+
+	t.Run("Singleton reports no edges", func(t *testing.T) {
+		id, _ := scc.GetComponentId(4)
+
+		if len(componentEdges[id]) != 0 {
+			t.Errorf("Expected no internal edges, got %d", len(componentEdges[id]))
+		}
+	})
+
+	t.Run("Singleton with self-loop reports it", func(t *testing.T) {
+		id, _ := scc.GetComponentId(5)
+		edges := componentEdges[id]
+
+		if len(edges) != 1 || edges[0].GetCost() != 6.0 {
+			t.Errorf("Expected the self-loop edge, got %v", edges)
+		}
+	})
+}
+
+func TestStronglyConnectedComponentsCondensation(t *testing.T) {
+	t.Run("Single cycle condenses to one vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		condensed, mapping := FindStronglyConnectedComponents(graph).Condensation()
+
+		if condensed.GetVertexCount() != 1 || condensed.GetEdgeCount() != 0 {
+			t.Errorf("Expected 1 vertex and 0 edges, got %d and %d", condensed.GetVertexCount(), condensed.GetEdgeCount())
+		}
+		if mapping[1] != 0 || mapping[2] != 0 || mapping[3] != 0 {
+			t.Errorf("Expected all vertices to map to component 0, got %v", mapping)
+		}
+		vertex, _ := condensed.GetVertexById(0)
+		members, _ := condensed.GetVertexData(vertex)
+		if len(*members) != 3 {
+			t.Errorf("Expected 3 members, got %v", *members)
+		}
+	})
+
+	t.Run("DAG condenses to itself", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 2.0, "edge1-3")
+		builder.AddEdge(2, 4, 3.0, "edge2-4")
+		builder.AddEdge(3, 4, 4.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		condensed, mapping := FindStronglyConnectedComponents(graph).Condensation()
+
+		if condensed.GetVertexCount() != 4 || condensed.GetEdgeCount() != 4 {
+			t.Fatalf("Expected 4 vertices and 4 edges, got %d and %d", condensed.GetVertexCount(), condensed.GetEdgeCount())
+		}
+		graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			origin, _ := condensed.GetVertexById(mapping[vertex.GetId()])
+			found := false
+			for _, condensedEdge := range origin.GetEdges() {
+				if condensedEdge.GetTargetVertex().GetId() == mapping[edge.GetTargetVertex().GetId()] {
+					found = condensedEdge.GetCost() == edge.GetCost()
+				}
+			}
+			if !found {
+				t.Errorf("Expected edge %d->%d with cost %v in the condensed graph", vertex.GetId(), edge.GetTargetVertex().GetId(), edge.GetCost())
+			}
+		})
+		for id, componentId := range mapping {
+			vertex, _ := condensed.GetVertexById(componentId)
+			members, _ := condensed.GetVertexData(vertex)
+			if !slicesEqual(*members, []int{id}) {
+				t.Errorf("Expected members [%d] of component %d, got %v", id, componentId, *members)
+			}
+		}
+	})
+
+	t.Run("Edges between components are collapsed", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "cycle")
+		builder.AddBiEdge(3, 4, 1.0, "cycle")
+		builder.AddEdge(1, 3, 5.0, "expensive")
+		builder.AddEdge(2, 4, 2.0, "cheap")
+
+		graph := builder.BuildDirected()
+		condensed, mapping := FindStronglyConnectedComponents(graph).Condensation()
+
+		if condensed.GetVertexCount() != 2 || condensed.GetEdgeCount() != 1 {
+			t.Fatalf("Expected 2 vertices and 1 edge, got %d and %d", condensed.GetVertexCount(), condensed.GetEdgeCount())
+		}
+		origin, _ := condensed.GetVertexById(mapping[1])
+		edges := origin.GetEdges()
+		if len(edges) != 1 || edges[0].GetTargetVertex().GetId() != mapping[3] || edges[0].GetCost() != 2.0 {
+			t.Fatalf("Expected a single edge with cost 2, got %v", edges)
+		}
+		if data, _ := condensed.GetEdgeData(&edges[0]); *data != "cheap" {
+			t.Errorf("Expected edge data cheap, got %s", *data)
+		}
+		if !condensed.IsDAG() {
+			t.Errorf("Expected the condensed graph to be a DAG")
+		}
+	})
+}
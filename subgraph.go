@@ -0,0 +1,33 @@
+package graph
+
+// Subgraph builds the subgraph induced by the given vertices: a new graph containing
+// only these vertices and the edges whose both endpoints are among them.
+// The costs and custom data of the vertices and edges are preserved.
+// IDs that don't exist in the graph are silently ignored, as are repeated IDs.
+// Time complexity: O(K + E) where K is the number of the given IDs and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) Subgraph(ids []I) *Graph[I, C, V, E] {
+	included := make([]bool, len(g.vertices))
+	builder := &Builder[I, C, V, E]{}
+	for _, id := range ids {
+		idx, exists := g.idToIndex[id]
+		if !exists || included[idx] {
+			continue
+		}
+		included[idx] = true
+		builder.AddVertex(id, g.customVertexData[idx])
+	}
+
+	for i := range g.vertices {
+		if !included[i] {
+			continue
+		}
+		for _, edge := range g.vertices[i].edges {
+			if included[edge.targetVertex.GetCustomDataIndex()] {
+				builder.AddEdge(g.vertices[i].id, edge.targetVertex.id, edge.cost, g.customEdgeData[edge.customDataIndex])
+			}
+		}
+	}
+
+	return builder.BuildDirected()
+}
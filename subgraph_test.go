@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSubgraph(t *testing.T) {
+	t.Run("Extract a component", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+		builder.AddEdge(1, 2, 1.5, "edge1-2")
+		builder.AddEdge(2, 3, 2.5, "edge2-3")
+		builder.AddEdge(3, 1, 3.5, "edge3-1")
+		builder.AddEdge(4, 5, 4.5, "edge4-5")
+		builder.AddEdge(5, 6, 5.5, "edge5-6")
+
+		graph := builder.BuildDirected()
+		component := FindConnectedComponents(graph).GetComponentForVertex(1)
+		subgraph := graph.Subgraph(component)
+
+		if subgraph.GetVertexCount() != 3 {
+			t.Errorf("Expected 3 vertices, got %d", subgraph.GetVertexCount())
+		}
+
+		var edges []string
+		subgraph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			data, _ := subgraph.GetEdgeData(edge)
+			edges = append(edges, *data)
+			if *data == "edge2-3" && edge.GetCost() != 2.5 {
+				t.Errorf("Expected cost 2.5 of edge 2-3, got %f", edge.GetCost())
+			}
+		})
+		sort.Strings(edges)
+		if !slicesEqualString(edges, []string{"edge1-2", "edge2-3", "edge3-1"}) {
+			t.Errorf("Expected the internal edges of the component, got %v", edges)
+		}
+
+		vertex, _ := subgraph.GetVertexById(1)
+		data, _ := subgraph.GetVertexData(vertex)
+		if *data != "vertex1" {
+			t.Errorf("Expected vertex data 'vertex1', got '%s'", *data)
+		}
+	})
+
+	t.Run("Edges leaving the set are dropped", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		subgraph := graph.Subgraph([]int{2, 3})
+
+		if subgraph.GetVertexCount() != 2 || subgraph.GetEdgeCount() != 1 {
+			t.Errorf("Expected 2 vertices and 1 edge, got %d and %d", subgraph.GetVertexCount(), subgraph.GetEdgeCount())
+		}
+	})
+
+	t.Run("Unknown and repeated IDs are ignored", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		subgraph := graph.Subgraph([]int{1, 999, 1, 2})
+
+		if subgraph.GetVertexCount() != 2 || subgraph.GetEdgeCount() != 1 {
+			t.Errorf("Expected 2 vertices and 1 edge, got %d and %d", subgraph.GetVertexCount(), subgraph.GetEdgeCount())
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package graph
+
+// topologicalOrder computes a topological order of the vertices using Kahn's algorithm.
+// Returns the vertex indexes in topological order and true, or nil and false if the
+// graph contains a directed cycle (including self-loops).
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) topologicalOrder() ([]int, bool) {
+	inDegree := make([]int, len(g.vertices))
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			inDegree[edge.targetVertex.GetCustomDataIndex()]++
+		}
+	}
+
+	order := make([]int, 0, len(g.vertices))
+	for i := range inDegree {
+		if inDegree[i] == 0 {
+			order = append(order, i)
+		}
+	}
+	for head := 0; head < len(order); head++ {
+		for _, edge := range g.vertices[order[head]].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			inDegree[targetIdx]--
+			if inDegree[targetIdx] == 0 {
+				order = append(order, targetIdx)
+			}
+		}
+	}
+
+	if len(order) != len(g.vertices) {
+		return nil, false
+	}
+	return order, true
+}
+
+// IsDAG checks whether the graph is a directed acyclic graph, i.e. it has no directed
+// cycle, self-loops included. Algorithms that require a DAG, such as the topological
+// ordering or the longest path, can use it to reject the input upfront.
+// It peels off the vertices without incoming edges iteratively (Kahn's algorithm),
+// so arbitrarily deep graphs are handled without recursion.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) IsDAG() bool {
+	_, ok := g.topologicalOrder()
+	return ok
+}
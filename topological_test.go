@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestIsDAG(t *testing.T) {
+	t.Run("Chain", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+		if !graph.IsDAG() {
+			t.Errorf("Expected a chain to be a DAG")
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+		if graph.IsDAG() {
+			t.Errorf("Expected a cycle not to be a DAG")
+		}
+	})
+
+	t.Run("Self-loop", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 2, 1.0, "loop")
+
+		graph := builder.BuildDirected()
+		if graph.IsDAG() {
+			t.Errorf("Expected a self-loop not to be a DAG")
+		}
+	})
+
+	t.Run("Bidirectional edge", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if graph.IsDAG() {
+			t.Errorf("Expected a bidirectional edge not to be a DAG")
+		}
+	})
+
+	t.Run("Deep chain", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		for i := 0; i < 100000; i++ {
+			builder.AddEdge(i, i+1, 1.0, "edge")
+		}
+
+		graph := builder.BuildDirected()
+		if !graph.IsDAG() {
+			t.Errorf("Expected a deep chain to be a DAG")
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if !graph.IsDAG() {
+			t.Errorf("Expected an empty graph to be a DAG")
+		}
+	})
+}
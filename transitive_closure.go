@@ -0,0 +1,40 @@
+package graph
+
+// TransitiveClosure builds the transitive closure of the graph: a new graph with the
+// same vertices (and vertex data) containing an edge u->v whenever v is reachable from u
+// through a path of at least one edge. So u->u is present only if u lies on a cycle.
+// The closure edges carry the zero cost and zero custom data.
+// Reachability is computed by a breadth-first search from every vertex.
+// Time complexity: O(V * (V + E)) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V^2) in the worst case, for the edges of the resulting graph.
+func (g *Graph[I, C, V, E]) TransitiveClosure() *Graph[I, C, V, E] {
+	builder := &Builder[I, C, V, E]{}
+	for i := range g.vertices {
+		builder.AddVertex(g.vertices[i].id, g.customVertexData[g.vertices[i].customDataIndex])
+	}
+
+	var zeroCost C
+	var zeroData E
+	visited := make([]int, len(g.vertices)) // Stamp of the last search visiting each vertex
+	for i := range visited {
+		visited[i] = -1
+	}
+	queue := make([]int, 0, len(g.vertices))
+
+	for source := range g.vertices {
+		queue = append(queue[:0], source)
+		for head := 0; head < len(queue); head++ {
+			for _, edge := range g.vertices[queue[head]].edges {
+				targetIdx := edge.targetVertex.GetCustomDataIndex()
+				if visited[targetIdx] == source {
+					continue
+				}
+				visited[targetIdx] = source
+				queue = append(queue, targetIdx)
+				builder.AddEdge(g.vertices[source].id, edge.targetVertex.id, zeroCost, zeroData)
+			}
+		}
+	}
+
+	return builder.BuildDirected()
+}
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestTransitiveClosure(t *testing.T) {
+	// hasEdge reports whether the graph has an edge between the given vertices
+	hasEdge := func(graph *Graph[int, float64, string, string], from, to int) bool {
+		return graph.SomeEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) bool {
+			return vertex.GetId() == from && edge.GetTargetVertex().GetId() == to
+		})
+	}
+
+	t.Run("Chain contains all forward pairs", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		closure := graph.TransitiveClosure()
+
+		if closure.GetEdgeCount() != 6 {
+			t.Errorf("Expected 6 edges in the closure, got %d", closure.GetEdgeCount())
+		}
+
+		for from := 1; from <= 4; from++ {
+			for to := 1; to <= 4; to++ {
+				if hasEdge(closure, from, to) != (from < to) {
+					t.Errorf("Unexpected presence of edge %d -> %d: %v", from, to, hasEdge(closure, from, to))
+				}
+			}
+		}
+	})
+
+	t.Run("Cycle vertices reach themselves", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 1, 1.0, "edge2-1")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+
+		graph := builder.BuildDirected()
+		closure := graph.TransitiveClosure()
+
+		if !hasEdge(closure, 1, 1) || !hasEdge(closure, 2, 2) {
+			t.Error("Expected self-edges for the vertices on the cycle")
+		}
+
+		if hasEdge(closure, 3, 3) || hasEdge(closure, 3, 1) {
+			t.Error("Expected no edges out of the sink")
+		}
+
+		if closure.GetEdgeCount() != 6 {
+			t.Errorf("Expected 6 edges in the closure, got %d", closure.GetEdgeCount())
+		}
+	})
+
+	t.Run("Vertex data is preserved", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "A")
+		builder.AddVertex(2, "B")
+		builder.AddVertex(3, "isolated")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+		closure := graph.TransitiveClosure()
+
+		if closure.GetVertexCount() != 3 {
+			t.Errorf("Expected 3 vertices, got %d", closure.GetVertexCount())
+		}
+
+		vertex, _ := closure.GetVertexById(3)
+		data, _ := closure.GetVertexData(vertex)
+		if *data != "isolated" {
+			t.Errorf("Expected vertex data 'isolated', got %q", *data)
+		}
+	})
+}
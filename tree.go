@@ -0,0 +1,52 @@
+package graph
+
+// IsTree checks whether the undirected interpretation of the graph is a tree,
+// i.e. a connected forest (see IsForest), which has exactly V-1 edges.
+// Edges connecting the same pair of vertices in either direction count as one
+// undirected edge, so a graph built with AddBiEdge is handled naturally, while
+// self-loops are cycles. An empty graph isn't considered a tree.
+// Time complexity: O((V + E) * α(V)) where V is the number of vertices, E is the number of edges
+// and α is the inverse Ackermann function.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) IsTree() bool {
+	acyclic, components := g.countForestComponents()
+	return acyclic && components == 1
+}
+
+// IsForest checks whether the undirected interpretation of the graph has no cycles,
+// i.e. every connected component is a tree. Edges connecting the same pair of
+// vertices in either direction count as one undirected edge, while self-loops are
+// cycles. An empty graph is a forest.
+// Time complexity: O((V + E) * α(V)) where V is the number of vertices, E is the number of edges
+// and α is the inverse Ackermann function.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) IsForest() bool {
+	acyclic, _ := g.countForestComponents()
+	return acyclic
+}
+
+// countForestComponents merges the endpoints of every undirected edge with union-find,
+// detecting a cycle as soon as an edge connects two vertices that are already connected.
+// Returns whether the undirected interpretation is acyclic and, if so, the number of
+// its connected components.
+func (g *Graph[I, C, V, E]) countForestComponents() (bool, int) {
+	adjacency := g.undirectedAdjacency()
+	uf := newUnionFind(len(g.vertices))
+	components := len(g.vertices)
+	for i := range adjacency {
+		for _, neighborIdx := range adjacency[i] {
+			if neighborIdx == i {
+				return false, 0 // Self-loop
+			}
+			// Each undirected edge is listed at both of its ends
+			if neighborIdx < i {
+				continue
+			}
+			if !uf.union(i, neighborIdx) {
+				return false, 0
+			}
+			components--
+		}
+	}
+	return true, components
+}
@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestIsTree(t *testing.T) {
+	t.Run("Path graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+
+		if !graph.IsTree() {
+			t.Error("Expected path graph to be a tree")
+		}
+	})
+
+	t.Run("Bidirectional edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(1, 3, 1.0, "edge1-3")
+		builder.AddBiEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+
+		if !graph.IsTree() {
+			t.Error("Expected bidirectional tree to be a tree")
+		}
+	})
+
+	t.Run("Forest", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddVertex(5, "vertex5")
+
+		graph := builder.BuildDirected()
+
+		if graph.IsTree() {
+			t.Error("Expected disconnected forest not to be a tree")
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+
+		graph := builder.BuildDirected()
+
+		if graph.IsTree() {
+			t.Error("Expected graph with a cycle not to be a tree")
+		}
+	})
+
+	t.Run("Cycle and isolated vertex with V-1 edges", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 1, 1.0, "edge3-1")
+		builder.AddVertex(4, "vertex4")
+
+		graph := builder.BuildDirected()
+
+		if graph.IsTree() {
+			t.Error("Expected disconnected graph with a cycle not to be a tree")
+		}
+	})
+
+	t.Run("Self-loop", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 1, 1.0, "edge1-1")
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+
+		if graph.IsTree() {
+			t.Error("Expected graph with a self-loop not to be a tree")
+		}
+	})
+
+	t.Run("Single vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddVertex(1, "vertex1")
+
+		graph := builder.BuildDirected()
+
+		if !graph.IsTree() {
+			t.Error("Expected single vertex to be a tree")
+		}
+	})
+}
+
+func TestIsForest(t *testing.T) {
+	t.Run("Chain", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+
+		graph := builder.BuildDirected()
+		if !graph.IsForest() || !graph.IsTree() {
+			t.Errorf("Expected a chain to be a tree and a forest")
+		}
+	})
+
+	t.Run("Chain with an extra edge forming a cycle", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 2, 1.0, "edge4-2")
+
+		graph := builder.BuildDirected()
+		if graph.IsForest() || graph.IsTree() {
+			t.Errorf("Expected a cycle to be neither a tree nor a forest")
+		}
+	})
+
+	t.Run("Two disjoint chains", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(4, 5, 1.0, "edge4-5")
+
+		graph := builder.BuildDirected()
+		if !graph.IsForest() {
+			t.Errorf("Expected two chains to be a forest")
+		}
+		if graph.IsTree() {
+			t.Errorf("Expected two chains not to be a tree")
+		}
+	})
+
+	t.Run("Self-loop", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 2, 1.0, "loop")
+
+		graph := builder.BuildDirected()
+		if graph.IsForest() {
+			t.Errorf("Expected a self-loop not to be a forest")
+		}
+	})
+
+	t.Run("Empty graph", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		graph := builder.BuildDirected()
+
+		if !graph.IsForest() {
+			t.Errorf("Expected an empty graph to be a forest")
+		}
+		if graph.IsTree() {
+			t.Errorf("Expected an empty graph not to be a tree")
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package graph
+
+// Literal is a boolean variable or its negation in a 2-SAT formula, in the DIMACS
+// convention: the variables are numbered from 1, the literal k stands for the k-th
+// variable and the literal -k for its negation.
+type Literal int
+
+// TwoSAT is a solver of the 2-satisfiability problem: it finds an assignment of boolean
+// variables satisfying a conjunction of clauses with two literals each, e.g. for
+// scheduling with pairwise constraints. Every clause (a OR b) is turned into the two
+// implications (NOT a -> b) and (NOT b -> a) of the implication graph, and the formula
+// is satisfiable if and only if no variable is in the same strongly connected component
+// of that graph as its negation.
+type TwoSAT struct {
+	numVars int
+	clauses [][2]Literal
+}
+
+// NewTwoSAT creates a 2-SAT solver for the variables from 1 to numVars without clauses.
+func NewTwoSAT(numVars int) *TwoSAT {
+	return &TwoSAT{numVars: numVars}
+}
+
+// AddClause adds the clause (a OR b) to the formula. A unit clause, which requires
+// a single literal to be true, can be added as (a OR a).
+// Panics if a literal refers to a variable out of the 1..numVars range.
+func (ts *TwoSAT) AddClause(a Literal, b Literal) {
+	for _, literal := range []Literal{a, b} {
+		if literal == 0 || literal > Literal(ts.numVars) || literal < -Literal(ts.numVars) {
+			panic("literal out of range")
+		}
+	}
+	ts.clauses = append(ts.clauses, [2]Literal{a, b})
+}
+
+// literalVertex returns the vertex ID of the literal in the implication graph:
+// 2*(k-1) for the k-th variable and 2*(k-1)+1 for its negation.
+func literalVertex(literal Literal) int {
+	if literal < 0 {
+		return 2*int(-literal-1) + 1
+	}
+	return 2 * int(literal-1)
+}
+
+// Solve checks whether the formula is satisfiable and finds a satisfying assignment.
+// Returns the values of all the variables and true, or nil and false if the formula
+// is unsatisfiable.
+// Time complexity: O(N + M) where N is the number of variables and M is the number of clauses.
+// Space complexity: O(N + M) where N is the number of variables and M is the number of clauses.
+func (ts *TwoSAT) Solve() (map[int]bool, bool) {
+	builder := &Builder[int, int, struct{}, struct{}]{}
+	builder.Reserve(2*ts.numVars, 2*len(ts.clauses))
+	for i := 0; i < 2*ts.numVars; i++ {
+		builder.AddVertex(i, struct{}{})
+	}
+	for _, clause := range ts.clauses {
+		a, b := clause[0], clause[1]
+		builder.AddEdge(literalVertex(-a), literalVertex(b), 1, struct{}{})
+		builder.AddEdge(literalVertex(-b), literalVertex(a), 1, struct{}{})
+	}
+	scc := FindStronglyConnectedComponents(builder.BuildDirected())
+
+	assignment := make(map[int]bool, ts.numVars)
+	for v := 1; v <= ts.numVars; v++ {
+		positive, _ := scc.GetComponentId(literalVertex(Literal(v)))
+		negative, _ := scc.GetComponentId(literalVertex(Literal(-v)))
+		if positive == negative {
+			return nil, false
+		}
+		// The component IDs are in reverse topological order, so the literal whose
+		// component comes later in the topological order can't imply its negation
+		assignment[v] = positive < negative
+	}
+	return assignment, true
+}
@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestTwoSAT(t *testing.T) {
+	satisfies := func(assignment map[int]bool, clauses [][2]Literal) bool {
+		value := func(literal Literal) bool {
+			if literal < 0 {
+				return !assignment[int(-literal)]
+			}
+			return assignment[int(literal)]
+		}
+		for _, clause := range clauses {
+			if !value(clause[0]) && !value(clause[1]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	t.Run("Satisfiable", func(t *testing.T) {
+		clauses := [][2]Literal{
+			{1, 2},
+			{-1, 3},
+			{-2, -3},
+			{2, 4},
+			{-4, -1},
+		}
+		ts := NewTwoSAT(4)
+		for _, clause := range clauses {
+			ts.AddClause(clause[0], clause[1])
+		}
+
+		assignment, ok := ts.Solve()
+
+		if !ok {
+			t.Fatalf("Expected the formula to be satisfiable")
+		}
+		if len(assignment) != 4 {
+			t.Errorf("Expected 4 variables, got %d", len(assignment))
+		}
+		if !satisfies(assignment, clauses) {
+			t.Errorf("Expected a satisfying assignment, got %v", assignment)
+		}
+	})
+
+	t.Run("Unit clauses", func(t *testing.T) {
+		ts := NewTwoSAT(2)
+		ts.AddClause(-1, -1)
+		ts.AddClause(1, 2)
+
+		assignment, ok := ts.Solve()
+
+		if !ok || assignment[1] || !assignment[2] {
+			t.Errorf("Expected x1 = false and x2 = true, got %v (%v)", assignment, ok)
+		}
+	})
+
+	t.Run("Unsatisfiable", func(t *testing.T) {
+		ts := NewTwoSAT(2)
+		ts.AddClause(1, 2)
+		ts.AddClause(1, -2)
+		ts.AddClause(-1, 2)
+		ts.AddClause(-1, -2)
+
+		assignment, ok := ts.Solve()
+
+		if ok || assignment != nil {
+			t.Errorf("Expected the formula to be unsatisfiable, got %v", assignment)
+		}
+	})
+
+	t.Run("No clauses", func(t *testing.T) {
+		assignment, ok := NewTwoSAT(3).Solve()
+
+		if !ok || len(assignment) != 3 {
+			t.Errorf("Expected an assignment of 3 variables, got %v (%v)", assignment, ok)
+		}
+	})
+
+	t.Run("Literal out of range", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Expected a panic")
+			}
+		}()
+		NewTwoSAT(2).AddClause(1, 3)
+	})
+}
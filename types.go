@@ -2,7 +2,6 @@ package graph
 
 import (
 	"math"
-	"reflect"
 )
 
 // SInt represents signed integer types that can be used as vertex IDs or edge costs.
@@ -37,37 +36,74 @@ type Cost interface {
 	SInt | UInt | Float
 }
 
-// Implements std::numeric_limits<T>::max() in a Go way.
-func assignMaxNumber(v interface{}) {
-	val := reflect.ValueOf(v).Elem()
-	switch val.Kind() {
-	case reflect.Int:
-		val.SetInt(math.MaxInt)
-	case reflect.Int8:
-		val.SetInt(math.MaxInt8)
-	case reflect.Int16:
-		val.SetInt(math.MaxInt16)
-	case reflect.Int32:
-		val.SetInt(math.MaxInt32)
-	case reflect.Int64:
-		val.SetInt(math.MaxInt64)
-	case reflect.Uint:
-		val.SetUint(math.MaxUint)
-	case reflect.Uint8:
-		val.SetUint(math.MaxUint8)
-	case reflect.Uint16:
-		val.SetUint(math.MaxUint16)
-	case reflect.Uint32:
-		val.SetUint(math.MaxUint32)
-	case reflect.Uint64:
-		val.SetUint(math.MaxUint64)
-	case reflect.Float32:
-		val.SetFloat(math.MaxFloat32)
-	case reflect.Float64:
-		val.SetFloat(math.MaxFloat64)
-	default:
-		panic("Unsupported type")
+// maxCost returns the maximum value of the cost type, like std::numeric_limits<T>::max().
+// The type switch is resolved without reflection, so it's cheap enough for constructors.
+func maxCost[C Cost]() C {
+	var max C
+	switch p := any(&max).(type) {
+	case *int:
+		*p = math.MaxInt
+	case *int8:
+		*p = math.MaxInt8
+	case *int16:
+		*p = math.MaxInt16
+	case *int32:
+		*p = math.MaxInt32
+	case *int64:
+		*p = math.MaxInt64
+	case *uint:
+		*p = math.MaxUint
+	case *uint8:
+		*p = math.MaxUint8
+	case *uint16:
+		*p = math.MaxUint16
+	case *uint32:
+		*p = math.MaxUint32
+	case *uint64:
+		*p = math.MaxUint64
+	case *float32:
+		*p = math.MaxFloat32
+	case *float64:
+		*p = math.MaxFloat64
 	}
+	return max
+}
+
+// minCost returns the minimum value of the cost type, like std::numeric_limits<T>::lowest().
+func minCost[C Cost]() C {
+	var min C
+	switch p := any(&min).(type) {
+	case *int:
+		*p = math.MinInt
+	case *int8:
+		*p = math.MinInt8
+	case *int16:
+		*p = math.MinInt16
+	case *int32:
+		*p = math.MinInt32
+	case *int64:
+		*p = math.MinInt64
+	case *float32:
+		*p = -math.MaxFloat32
+	case *float64:
+		*p = -math.MaxFloat64
+	}
+	return min
+}
+
+// saturatingAdd returns a + b clamped to the range of the cost type instead of
+// wrapping around on integer overflow, so that huge distances stay huge.
+// Floating-point costs never wrap, so they're returned as is.
+func saturatingAdd[C Cost](a C, b C) C {
+	sum := a + b
+	var zero C
+	if b > zero && sum < a {
+		return maxCost[C]()
+	}
+	if b < zero && sum > a {
+		return minCost[C]()
+	}
+	return sum
 }
 
 type CostFunc[I Id, C Cost, V any, E any] func(origin *Vertex[I, C], edge *Edge[I, C]) (C, bool)
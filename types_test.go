@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"math"
 	"testing"
 )
 
@@ -56,3 +57,63 @@ func testId[T Id](val T) T {
 func testCost[T Cost](val T) T {
 	return val
 }
+
+func TestMaxCost(t *testing.T) {
+	if v := maxCost[int](); v != math.MaxInt {
+		t.Errorf("Expected %d for int, got %d", math.MaxInt, v)
+	}
+	if v := maxCost[int8](); v != math.MaxInt8 {
+		t.Errorf("Expected %d for int8, got %d", math.MaxInt8, v)
+	}
+	if v := maxCost[int16](); v != math.MaxInt16 {
+		t.Errorf("Expected %d for int16, got %d", math.MaxInt16, v)
+	}
+	if v := maxCost[int32](); v != math.MaxInt32 {
+		t.Errorf("Expected %d for int32, got %d", math.MaxInt32, v)
+	}
+	if v := maxCost[int64](); v != math.MaxInt64 {
+		t.Errorf("Expected %d for int64, got %d", int64(math.MaxInt64), v)
+	}
+	if v := maxCost[uint](); v != math.MaxUint {
+		t.Errorf("Expected %d for uint, got %d", uint(math.MaxUint), v)
+	}
+	if v := maxCost[uint8](); v != math.MaxUint8 {
+		t.Errorf("Expected %d for uint8, got %d", math.MaxUint8, v)
+	}
+	if v := maxCost[uint16](); v != math.MaxUint16 {
+		t.Errorf("Expected %d for uint16, got %d", math.MaxUint16, v)
+	}
+	if v := maxCost[uint32](); v != math.MaxUint32 {
+		t.Errorf("Expected %d for uint32, got %d", uint32(math.MaxUint32), v)
+	}
+	if v := maxCost[uint64](); v != math.MaxUint64 {
+		t.Errorf("Expected %d for uint64, got %d", uint64(math.MaxUint64), v)
+	}
+	if v := maxCost[float32](); v != math.MaxFloat32 {
+		t.Errorf("Expected %g for float32, got %g", math.MaxFloat32, v)
+	}
+	if v := maxCost[float64](); v != math.MaxFloat64 {
+		t.Errorf("Expected %g for float64, got %g", math.MaxFloat64, v)
+	}
+}
+
+func TestSaturatingAdd(t *testing.T) {
+	if v := saturatingAdd[uint16](40000, 40000); v != math.MaxUint16 {
+		t.Errorf("Expected %d, got %d", math.MaxUint16, v)
+	}
+	if v := saturatingAdd[int32](math.MaxInt32-1, 5); v != math.MaxInt32 {
+		t.Errorf("Expected %d, got %d", math.MaxInt32, v)
+	}
+	if v := saturatingAdd[int8](-100, -100); v != math.MinInt8 {
+		t.Errorf("Expected %d, got %d", math.MinInt8, v)
+	}
+	if v := saturatingAdd[int](5, -7); v != -2 {
+		t.Errorf("Expected -2, got %d", v)
+	}
+	if v := saturatingAdd[uint8](200, 55); v != 255 {
+		t.Errorf("Expected 255, got %d", v)
+	}
+	if v := saturatingAdd[float64](1.5, 2.5); v != 4 {
+		t.Errorf("Expected 4, got %f", v)
+	}
+}
@@ -0,0 +1,94 @@
+package graph
+
+// undirectedAdjacency builds the adjacency lists of the undirected interpretation
+// of the graph, where every directed edge connects both of its endpoints.
+// Neighbors are referenced by vertex index and each neighbor is listed only once
+// per vertex, no matter how many edges connect the pair. Self-loops are kept.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) undirectedAdjacency() [][]int {
+	adjacency := make([][]int, len(g.vertices))
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			adjacency[i] = append(adjacency[i], targetIdx)
+			if targetIdx != i {
+				adjacency[targetIdx] = append(adjacency[targetIdx], i)
+			}
+		}
+	}
+
+	// Remove duplicate neighbors using a per-vertex stamp instead of a set
+	stamp := make([]int, len(g.vertices))
+	for i := range stamp {
+		stamp[i] = -1
+	}
+	for i := range adjacency {
+		unique := adjacency[i][:0]
+		for _, neighborIdx := range adjacency[i] {
+			if stamp[neighborIdx] != i {
+				stamp[neighborIdx] = i
+				unique = append(unique, neighborIdx)
+			}
+		}
+		adjacency[i] = unique
+	}
+
+	return adjacency
+}
+
+// costArc is an arc of the adjacency lists built by costAdjacency.
+type costArc[C Cost] struct {
+	targetIdx int
+	cost      C
+}
+
+// costAdjacency builds the adjacency lists with the arc costs of either the directed
+// graph or its undirected interpretation. In the directed mode the lists mirror the
+// outgoing edges as they are. In the undirected mode every edge connects both of its
+// endpoints, each neighbor is listed only once per vertex and the cheapest of the
+// edges connecting the pair (in either direction) determines the cost.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) costAdjacency(directed bool) [][]costArc[C] {
+	adjacency := make([][]costArc[C], len(g.vertices))
+	if directed {
+		for i := range g.vertices {
+			adjacency[i] = make([]costArc[C], len(g.vertices[i].edges))
+			for j, edge := range g.vertices[i].edges {
+				adjacency[i][j] = costArc[C]{targetIdx: edge.targetVertex.GetCustomDataIndex(), cost: edge.cost}
+			}
+		}
+		return adjacency
+	}
+
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			adjacency[i] = append(adjacency[i], costArc[C]{targetIdx: targetIdx, cost: edge.cost})
+			if targetIdx != i {
+				adjacency[targetIdx] = append(adjacency[targetIdx], costArc[C]{targetIdx: i, cost: edge.cost})
+			}
+		}
+	}
+
+	// Merge duplicate neighbors keeping the cheapest arc, using a per-vertex stamp
+	stamp := make([]int, len(g.vertices))
+	position := make([]int, len(g.vertices))
+	for i := range stamp {
+		stamp[i] = -1
+	}
+	for i := range adjacency {
+		unique := adjacency[i][:0]
+		for _, arc := range adjacency[i] {
+			if stamp[arc.targetIdx] != i {
+				stamp[arc.targetIdx] = i
+				position[arc.targetIdx] = len(unique)
+				unique = append(unique, arc)
+			} else if arc.cost < unique[position[arc.targetIdx]].cost {
+				unique[position[arc.targetIdx]].cost = arc.cost
+			}
+		}
+		adjacency[i] = unique
+	}
+
+	return adjacency
+}
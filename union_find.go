@@ -0,0 +1,45 @@
+package graph
+
+// unionFind is a disjoint-set forest over the vertex indexes with union by size
+// and path halving, so that both operations take nearly constant amortized time.
+type unionFind struct {
+	parent []int
+	size   []int
+}
+
+// newUnionFind creates a disjoint-set forest where every element is a separate set.
+func newUnionFind(count int) *unionFind {
+	uf := &unionFind{
+		parent: make([]int, count),
+		size:   make([]int, count),
+	}
+	for i := range uf.parent {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+	return uf
+}
+
+// find returns the representative element of the set containing the element.
+func (uf *unionFind) find(x int) int {
+	for uf.parent[x] != x {
+		uf.parent[x] = uf.parent[uf.parent[x]]
+		x = uf.parent[x]
+	}
+	return x
+}
+
+// union merges the sets containing the elements.
+// Returns false if they are already in the same set.
+func (uf *unionFind) union(x int, y int) bool {
+	rootX, rootY := uf.find(x), uf.find(y)
+	if rootX == rootY {
+		return false
+	}
+	if uf.size[rootX] < uf.size[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+	uf.parent[rootY] = rootX
+	uf.size[rootX] += uf.size[rootY]
+	return true
+}
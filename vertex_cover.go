@@ -0,0 +1,31 @@
+package graph
+
+// VertexCoverApprox finds a small vertex cover, i.e. a set of vertices touching every
+// edge, e.g. for placing monitors on a road network so every road is watched.
+// The graph is treated as undirected. Finding the minimum vertex cover is NP-hard,
+// so the classic 2-approximation is used: both endpoints of every edge that isn't
+// covered yet are picked. The picked edges form a matching, and any cover must contain
+// at least one endpoint of each of them, so the result is at most twice the optimum.
+// A self-loop is covered by its only endpoint.
+// Returns the picked vertices in the order they were picked.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) VertexCoverApprox() []I {
+	covered := make([]bool, len(g.vertices))
+	var cover []I
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			targetIdx := edge.targetVertex.GetCustomDataIndex()
+			if covered[i] || covered[targetIdx] {
+				continue
+			}
+			covered[i] = true
+			cover = append(cover, g.vertices[i].id)
+			if targetIdx != i {
+				covered[targetIdx] = true
+				cover = append(cover, edge.targetVertex.id)
+			}
+		}
+	}
+	return cover
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphVertexCoverApprox(t *testing.T) {
+	checkCover := func(t *testing.T, graph *Graph[int, float64, string, string], cover []int) {
+		inCover := make(map[int]bool, len(cover))
+		for _, id := range cover {
+			inCover[id] = true
+		}
+		graph.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+			if !inCover[vertex.GetId()] && !inCover[edge.GetTargetVertex().GetId()] {
+				t.Errorf("Expected edge %d->%d to be covered", vertex.GetId(), edge.GetTargetVertex().GetId())
+			}
+		})
+	}
+
+	t.Run("Path", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1, "edge")
+		builder.AddBiEdge(2, 3, 1, "edge")
+		builder.AddBiEdge(3, 4, 1, "edge")
+
+		graph := builder.BuildDirected()
+		cover := graph.VertexCoverApprox()
+
+		if !slicesEqual(cover, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected cover [1 2 3 4], got %v", cover)
+		}
+		checkCover(t, graph, cover)
+	})
+
+	t.Run("Self-loop", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 1, 1, "loop")
+		builder.AddEdge(2, 1, 1, "edge")
+
+		graph := builder.BuildDirected()
+		cover := graph.VertexCoverApprox()
+
+		if !slicesEqual(cover, []int{1}) {
+			t.Errorf("Expected cover [1], got %v", cover)
+		}
+	})
+
+	t.Run("At most twice the maximum matching on bipartite graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			// Only the edges from the even to the odd vertices are kept
+			random := GenerateRandom(30, 0.1, seed,
+				func(origin int, target int) float64 { return 1 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			builder := &Builder[int, float64, string, string]{}
+			random.VisitEdges(func(vertex *Vertex[int, float64], edge *Edge[int, float64]) {
+				if vertex.GetId()%2 == 0 && edge.GetTargetVertex().GetId()%2 == 1 {
+					builder.AddEdge(vertex.GetId(), edge.GetTargetVertex().GetId(), 1, "edge")
+				}
+			})
+			graph := builder.BuildDirected()
+			left, right, _ := graph.Bipartition()
+
+			cover := graph.VertexCoverApprox()
+			_, matchingSize := graph.MaximumBipartiteMatching(left, right)
+
+			checkCover(t, graph, cover)
+			// By König's theorem the minimum cover is as large as the maximum matching
+			if len(cover) > 2*matchingSize {
+				t.Errorf("Seed %d: expected cover of at most %d vertices, got %d", seed, 2*matchingSize, len(cover))
+			}
+		}
+	})
+}
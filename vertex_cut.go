@@ -0,0 +1,76 @@
+package graph
+
+import "errors"
+
+// MinVertexCut finds a minimum s-t vertex cut: the smallest set of vertices (other
+// than s and t themselves) whose removal disconnects t from s.
+// Every vertex v is split into v_in and v_out connected by an arc of the unit capacity,
+// and every edge u->v becomes an arc u_out->v_in of the unbounded capacity. The max flow
+// from s_out to t_in then equals the size of the cut, and the cut consists of the
+// vertices whose split arcs are saturated and cross the residual reachability border.
+// Returns an empty cut if t isn't reachable from s. Returns an error if either vertex
+// doesn't exist, they are the same vertex or there is an edge from s to t, since no
+// vertex cut can separate them then.
+// Time complexity: O(V * E^2) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) MinVertexCut(s I, t I) ([]I, error) {
+	sVertex, err := g.GetVertexById(s)
+	if err != nil {
+		return nil, err
+	}
+	tVertex, err := g.GetVertexById(t)
+	if err != nil {
+		return nil, err
+	}
+	if s == t {
+		return nil, errors.New("source and sink must be different vertices")
+	}
+	sIdx, tIdx := sVertex.GetCustomDataIndex(), tVertex.GetCustomDataIndex()
+	for _, edge := range sVertex.edges {
+		if edge.targetVertex.GetCustomDataIndex() == tIdx {
+			return nil, errors.New("source and sink are adjacent, no vertex cut exists")
+		}
+	}
+
+	// The flow network is built over the split vertices, where v_in is 2v and v_out is 2v+1.
+	// The cut can't contain more than all the vertices, so that's enough for "unbounded".
+	vertexCount := len(g.vertices)
+	unbounded := vertexCount
+	mf := &MaxFlow[I, int, V, E]{
+		vertexArcs: make([][]int, 2*vertexCount),
+		parentArc:  make([]int, 2*vertexCount),
+		queue:      make([]int, 0, 2*vertexCount),
+	}
+	for i := range g.vertices {
+		capacity := 1
+		if i == sIdx || i == tIdx {
+			capacity = unbounded
+		}
+		mf.addArcPair(2*i, 2*i+1, capacity)
+	}
+	for i := range g.vertices {
+		for _, edge := range g.vertices[i].edges {
+			if targetIdx := edge.targetVertex.GetCustomDataIndex(); targetIdx != i {
+				mf.addArcPair(2*i+1, 2*targetIdx, unbounded)
+			}
+		}
+	}
+
+	sourceIdx, sinkIdx := 2*sIdx+1, 2*tIdx
+	mf.augment(sourceIdx, sinkIdx)
+
+	// The final BFS leaves the parent arcs set exactly for the vertices reachable
+	// from the source in the residual graph
+	mf.findAugmentingPath(sourceIdx, sinkIdx)
+	reachable := func(idx int) bool {
+		return idx == sourceIdx || mf.parentArc[idx] >= 0
+	}
+
+	cut := []I{}
+	for i := range g.vertices {
+		if i != sIdx && i != tIdx && reachable(2*i) && !reachable(2*i+1) {
+			cut = append(cut, g.vertices[i].id)
+		}
+	}
+	return cut, nil
+}
@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestMinVertexCut(t *testing.T) {
+	t.Run("Single separating vertex", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(3, 4, 1.0, "edge3-4")
+		builder.AddEdge(4, 5, 1.0, "edge4-5")
+		builder.AddEdge(4, 6, 1.0, "edge4-6")
+		builder.AddEdge(5, 7, 1.0, "edge5-7")
+		builder.AddEdge(6, 7, 1.0, "edge6-7")
+
+		graph := builder.BuildDirected()
+		cut, err := graph.MinVertexCut(1, 7)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !slicesEqual(cut, []int{4}) {
+			t.Errorf("Expected cut [4], got %v", cut)
+		}
+	})
+
+	t.Run("Two disjoint routes", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddBiEdge(1, 2, 1.0, "edge1-2")
+		builder.AddBiEdge(2, 3, 1.0, "edge2-3")
+		builder.AddBiEdge(3, 6, 1.0, "edge3-6")
+		builder.AddBiEdge(1, 4, 1.0, "edge1-4")
+		builder.AddBiEdge(4, 5, 1.0, "edge4-5")
+		builder.AddBiEdge(5, 6, 1.0, "edge5-6")
+		builder.AddBiEdge(2, 5, 1.0, "edge2-5")
+
+		graph := builder.BuildDirected()
+		cut, err := graph.MinVertexCut(1, 6)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		sort.Ints(cut)
+		if len(cut) != 2 {
+			t.Fatalf("Expected cut of 2 vertices, got %v", cut)
+		}
+
+		// Removing the cut must disconnect the terminals
+		remaining := []int{1, 6}
+		for _, id := range []int{2, 3, 4, 5} {
+			if id != cut[0] && id != cut[1] {
+				remaining = append(remaining, id)
+			}
+		}
+		if path := NewDijkstra(graph.Subgraph(remaining)).FindShortestPath(1, 6); path != nil {
+			t.Errorf("Expected no path after removing %v, got %v", cut, path)
+		}
+	})
+
+	t.Run("Unreachable sink", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(3, 2, 1.0, "edge3-2")
+
+		graph := builder.BuildDirected()
+		cut, err := graph.MinVertexCut(1, 3)
+
+		if err != nil || len(cut) != 0 {
+			t.Errorf("Expected empty cut and no error, got %v, %v", cut, err)
+		}
+	})
+
+	t.Run("Adjacent terminals", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 3, 1.0, "edge2-3")
+		builder.AddEdge(1, 3, 1.0, "edge1-3")
+
+		graph := builder.BuildDirected()
+
+		if _, err := graph.MinVertexCut(1, 3); err == nil {
+			t.Error("Expected error for adjacent terminals")
+		}
+	})
+
+	t.Run("Invalid terminals", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+
+		graph := builder.BuildDirected()
+
+		if _, err := graph.MinVertexCut(1, 1); err == nil {
+			t.Error("Expected error for the same terminals")
+		}
+		if _, err := graph.MinVertexCut(1, 999); err == nil {
+			t.Error("Expected error for non-existent vertex")
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package graph
+
+import "container/heap"
+
+// WidestPath finds the path between two vertices that maximizes the minimum edge cost
+// along it, e.g. for bandwidth routing. Edge costs are interpreted as capacities here.
+// It's a modified Dijkstra's algorithm where the priority of a vertex is the bottleneck
+// capacity of the best path found to it, and the relaxation keeps the maximum of the
+// minimum capacities.
+// Returns the path and its bottleneck capacity, or nil and zero if the end vertex isn't
+// reachable. If start and end are the same vertex, the capacity is the max value of
+// the cost type, since there are no edges to limit it.
+// Time complexity: O(E log V) where E is the number of edges and V is the number of vertices.
+// Space complexity: O(V) where V is the number of vertices.
+func (g *Graph[I, C, V, E]) WidestPath(start I, end I) ([]I, C) {
+	var zero C
+	startVertex, err := g.GetVertexById(start)
+	if err != nil {
+		return nil, zero
+	}
+	endVertex, err := g.GetVertexById(end)
+	if err != nil {
+		return nil, zero
+	}
+
+	vertexCount := len(g.vertices)
+	width := make([]C, vertexCount)
+	reached := make([]bool, vertexCount)
+	settled := make([]bool, vertexCount)
+	previous := make([]int, vertexCount)
+	startIdx, endIdx := startVertex.GetCustomDataIndex(), endVertex.GetCustomDataIndex()
+	width[startIdx] = maxCost[C]()
+	reached[startIdx] = true
+	previous[startIdx] = -1
+
+	pq := &maxCostHeap[C]{}
+	heap.Push(pq, costHeapItem[C]{cost: width[startIdx], vertexIdx: startIdx})
+	for pq.Len() > 0 {
+		currentIdx := heap.Pop(pq).(costHeapItem[C]).vertexIdx
+		if settled[currentIdx] {
+			continue
+		}
+		settled[currentIdx] = true
+		if currentIdx == endIdx {
+			break
+		}
+
+		for _, edge := range g.vertices[currentIdx].edges {
+			neighborIdx := edge.targetVertex.GetCustomDataIndex()
+			if settled[neighborIdx] {
+				continue
+			}
+			bottleneck := width[currentIdx]
+			if edge.cost < bottleneck {
+				bottleneck = edge.cost
+			}
+			if !reached[neighborIdx] || bottleneck > width[neighborIdx] {
+				reached[neighborIdx] = true
+				width[neighborIdx] = bottleneck
+				previous[neighborIdx] = currentIdx
+				heap.Push(pq, costHeapItem[C]{cost: bottleneck, vertexIdx: neighborIdx})
+			}
+		}
+	}
+
+	if !settled[endIdx] {
+		return nil, zero
+	}
+
+	var path []I
+	for idx := endIdx; idx >= 0; idx = previous[idx] {
+		path = append(path, g.vertices[idx].id)
+	}
+	reversePath(path)
+
+	return path, width[endIdx]
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestWidestPath(t *testing.T) {
+	t.Run("Widest path differs from the shortest one", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 1.0, "edge1-2")
+		builder.AddEdge(2, 4, 1.0, "edge2-4")
+		builder.AddEdge(1, 3, 10.0, "edge1-3")
+		builder.AddEdge(3, 4, 8.0, "edge3-4")
+		builder.AddEdge(1, 5, 20.0, "edge1-5")
+		builder.AddEdge(5, 4, 5.0, "edge5-4")
+
+		graph := builder.BuildDirected()
+
+		if path := NewDijkstra(graph).FindShortestPath(1, 4); !slicesEqual(path, []int{1, 2, 4}) {
+			t.Errorf("Expected shortest path [1 2 4], got %v", path)
+		}
+
+		path, width := graph.WidestPath(1, 4)
+		if !slicesEqual(path, []int{1, 3, 4}) {
+			t.Errorf("Expected widest path [1 3 4], got %v", path)
+		}
+		if width != 8.0 {
+			t.Errorf("Expected bottleneck 8, got %f", width)
+		}
+	})
+
+	t.Run("Integer capacities", func(t *testing.T) {
+		builder := &Builder[int, uint8, string, string]{}
+		builder.AddEdge(1, 2, 200, "edge1-2")
+		builder.AddEdge(2, 3, 100, "edge2-3")
+		builder.AddEdge(1, 3, 50, "edge1-3")
+
+		graph := builder.BuildDirected()
+		path, width := graph.WidestPath(1, 3)
+
+		if !slicesEqual(path, []int{1, 2, 3}) || width != 100 {
+			t.Errorf("Expected path [1 2 3] of width 100, got %v of width %d", path, width)
+		}
+	})
+
+	t.Run("Unreachable end", func(t *testing.T) {
+		builder := &Builder[int, float64, string, string]{}
+		builder.AddEdge(1, 2, 5.0, "edge1-2")
+		builder.AddVertex(3, "vertex3")
+
+		graph := builder.BuildDirected()
+		path, width := graph.WidestPath(1, 3)
+
+		if path != nil || width != 0 {
+			t.Errorf("Expected nil path of zero width, got %v of width %f", path, width)
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 5, "edge1-2")
+
+		graph := builder.BuildDirected()
+		path, width := graph.WidestPath(1, 1)
+
+		if !slicesEqual(path, []int{1}) || width != maxCost[int]() {
+			t.Errorf("Expected path [1] of max width, got %v of width %d", path, width)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package graph
+
+// indexDeque is a double-ended queue of vertex indexes backed by a ring buffer.
+type indexDeque struct {
+	items []int
+	head  int
+	size  int
+}
+
+// pushFront inserts the index at the front of the queue.
+func (q *indexDeque) pushFront(idx int) {
+	q.grow()
+	q.head = (q.head - 1 + len(q.items)) % len(q.items)
+	q.items[q.head] = idx
+	q.size++
+}
+
+// pushBack inserts the index at the back of the queue.
+func (q *indexDeque) pushBack(idx int) {
+	q.grow()
+	q.items[(q.head+q.size)%len(q.items)] = idx
+	q.size++
+}
+
+// popFront removes and returns the index at the front of the queue.
+// The queue must not be empty.
+func (q *indexDeque) popFront() int {
+	idx := q.items[q.head]
+	q.head = (q.head + 1) % len(q.items)
+	q.size--
+	return idx
+}
+
+// grow doubles the capacity of the ring buffer if it's full.
+func (q *indexDeque) grow() {
+	if q.size < len(q.items) {
+		return
+	}
+	capacity := 2 * len(q.items)
+	if capacity == 0 {
+		capacity = 16
+	}
+	items := make([]int, capacity)
+	for i := 0; i < q.size; i++ {
+		items[i] = q.items[(q.head+i)%len(q.items)]
+	}
+	q.items = items
+	q.head = 0
+}
+
+// ZeroOneBFS finds the shortest path between two vertices in a graph whose edge costs
+// are all either 0 or 1, e.g. an unweighted graph where some moves are free. It's a BFS
+// with a deque instead of Dijkstra's heap: the vertices relaxed via a 0-cost edge are
+// pushed to the front and those relaxed via a 1-cost edge to the back, so the vertices
+// are dequeued in the order of their distance.
+// Edge costs other than 0 or 1 are rejected: nil and zero are returned as soon as the
+// search encounters such an edge.
+// Returns the path and its total cost, or nil and zero if no path is found.
+// Time complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+// Space complexity: O(V + E) where V is the number of vertices and E is the number of edges.
+func (g *Graph[I, C, V, E]) ZeroOneBFS(start I, end I) ([]I, C) {
+	var zero C
+	startVertex, err := g.GetVertexById(start)
+	if err != nil {
+		return nil, zero
+	}
+	endVertex, err := g.GetVertexById(end)
+	if err != nil {
+		return nil, zero
+	}
+
+	vertexCount := len(g.vertices)
+	distance := make([]C, vertexCount)
+	reached := make([]bool, vertexCount)
+	settled := make([]bool, vertexCount)
+	previous := make([]int, vertexCount)
+	startIdx, endIdx := startVertex.GetCustomDataIndex(), endVertex.GetCustomDataIndex()
+	reached[startIdx] = true
+	previous[startIdx] = -1
+
+	deque := &indexDeque{}
+	deque.pushBack(startIdx)
+	for deque.size > 0 {
+		currentIdx := deque.popFront()
+		if settled[currentIdx] {
+			continue
+		}
+		settled[currentIdx] = true
+		if currentIdx == endIdx {
+			break
+		}
+
+		for _, edge := range g.vertices[currentIdx].edges {
+			if edge.cost != 0 && edge.cost != 1 {
+				return nil, zero
+			}
+			neighborIdx := edge.targetVertex.GetCustomDataIndex()
+			if settled[neighborIdx] {
+				continue
+			}
+			tentativeDistance := distance[currentIdx] + edge.cost
+			if !reached[neighborIdx] || tentativeDistance < distance[neighborIdx] {
+				reached[neighborIdx] = true
+				distance[neighborIdx] = tentativeDistance
+				previous[neighborIdx] = currentIdx
+				if edge.cost == 0 {
+					deque.pushFront(neighborIdx)
+				} else {
+					deque.pushBack(neighborIdx)
+				}
+			}
+		}
+	}
+
+	if !reached[endIdx] {
+		return nil, zero
+	}
+
+	var path []I
+	for idx := endIdx; idx >= 0; idx = previous[idx] {
+		path = append(path, g.vertices[idx].id)
+	}
+	reversePath(path)
+
+	return path, distance[endIdx]
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestZeroOneBFS(t *testing.T) {
+	t.Run("Free edges are preferred", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+		builder.AddEdge(2, 5, 1, "edge2-5")
+		builder.AddEdge(1, 3, 0, "edge1-3")
+		builder.AddEdge(3, 4, 1, "edge3-4")
+		builder.AddEdge(4, 5, 0, "edge4-5")
+
+		graph := builder.BuildDirected()
+		path, cost := graph.ZeroOneBFS(1, 5)
+
+		if !slicesEqual(path, []int{1, 3, 4, 5}) {
+			t.Errorf("Expected path [1 3 4 5], got %v", path)
+		}
+		if cost != 1 {
+			t.Errorf("Expected cost 1, got %d", cost)
+		}
+	})
+
+	t.Run("Matches Dijkstra on random 0/1 graphs", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			graph := GenerateRandom(50, 0.1, seed,
+				func(origin int, target int) int { return (origin*31 + target) % 2 },
+				func(id int) string { return "vertex" },
+				func(origin int, target int) string { return "edge" },
+			)
+			dijkstra := NewDijkstra(graph)
+			noVertexCost := func(*Vertex[int, int]) int { return 0 }
+
+			for end := 1; end < 50; end++ {
+				expectedPath, expectedCost := dijkstra.FindShortestPathWithVertexCost(0, end, noVertexCost)
+				path, cost := graph.ZeroOneBFS(0, end)
+				if (path == nil) != (expectedPath == nil) || cost != expectedCost {
+					t.Errorf("Seed %d, end %d: expected cost %d (path %v), got %d (path %v)",
+						seed, end, expectedCost, expectedPath, cost, path)
+				}
+			}
+		}
+	})
+
+	t.Run("Same start and end", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+
+		graph := builder.BuildDirected()
+		path, cost := graph.ZeroOneBFS(1, 1)
+
+		if !slicesEqual(path, []int{1}) || cost != 0 {
+			t.Errorf("Expected path [1] with cost 0, got %v with cost %d", path, cost)
+		}
+	})
+
+	t.Run("No path", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 1, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if path, cost := graph.ZeroOneBFS(2, 1); path != nil || cost != 0 {
+			t.Errorf("Expected nil path with cost 0, got %v with cost %d", path, cost)
+		}
+		if path, _ := graph.ZeroOneBFS(1, 99); path != nil {
+			t.Errorf("Expected nil path for a missing vertex, got %v", path)
+		}
+	})
+
+	t.Run("Costs outside 0 and 1 are rejected", func(t *testing.T) {
+		builder := &Builder[int, int, string, string]{}
+		builder.AddEdge(1, 2, 2, "edge1-2")
+
+		graph := builder.BuildDirected()
+		if path, cost := graph.ZeroOneBFS(1, 2); path != nil || cost != 0 {
+			t.Errorf("Expected nil path with cost 0, got %v with cost %d", path, cost)
+		}
+	})
+}